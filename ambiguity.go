@@ -0,0 +1,78 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ambiguousZones are the zone abbreviations in use for several zones, with
+// the ones they may stand for.
+var ambiguousZones = map[string]string{
+	"ast": "Atlantic or Arabia Standard Time",
+	"bst": "British Summer Time or Bangladesh Standard Time",
+	"cst": "Central Standard Time (North America), China or Cuba Standard Time",
+	"gst": "Gulf Standard Time or South Georgia Time",
+	"ist": "India, Israel or Irish Standard Time",
+	"pst": "Pacific Standard Time or Pakistan Standard Time",
+	"sst": "Samoa or Singapore Standard Time",
+}
+
+// ambiguity returns why str admits more than one plausible reading, or ""
+// when it doesn't: numeric dates whose field order can't be told (01/02/03),
+// lone four-digit numbers that are a valid time as well as a year (2023),
+// and zone abbreviations shared by several zones (IST).
+func ambiguity(str string) string {
+	words := strings.FieldsFunc(strings.ToLower(str), func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == ','
+	})
+	var reasons []string
+	for _, w := range words {
+		if zones, ok := ambiguousZones[w]; ok {
+			reasons = append(reasons, fmt.Sprintf("%s may be %s", strings.ToUpper(w), zones))
+			continue
+		}
+		if len(words) == 1 && len(w) == 4 && isAllDigits(w) && IsValidTime(digitsValue(w[:2]), digitsValue(w[2:]), 0) {
+			reasons = append(reasons, fmt.Sprintf("%s may be a year or the time %s:%s", w, w[:2], w[2:]))
+			continue
+		}
+		if orders := numericDateOrders(w); len(orders) > 1 {
+			reasons = append(reasons, fmt.Sprintf("%s may be %s", w, strings.Join(orders, " or ")))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// numericDateOrders returns the field orders w can be read in when it is a
+// numeric date of three fields with the same separator, and nil otherwise.
+func numericDateOrders(w string) []string {
+	sep := strings.IndexAny(w, "/-.")
+	if sep <= 0 {
+		return nil
+	}
+	a, b, c := splitNumericDate(w, w[sep])
+	var n [3]int
+	for i, p := range [3]string{a, b, c} {
+		if p == "" || len(p) > 4 || !isAllDigits(p) || (len(p) > 2 && i == 1) {
+			return nil
+		}
+		n[i] = digitsValue(p)
+	}
+	validMonthDay := func(month, day int) bool {
+		return month >= 1 && month <= 12 && day >= 1 && day <= 31
+	}
+	var orders []string
+	if len(a) <= 2 && (len(c) == 2 || len(c) == 4) {
+		if validMonthDay(n[0], n[1]) {
+			orders = append(orders, "month/day/year")
+		}
+		if n[0] != n[1] && validMonthDay(n[1], n[0]) {
+			orders = append(orders, "day/month/year")
+		}
+	}
+	if len(c) <= 2 && (len(a) == 2 || len(a) == 4) && validMonthDay(n[1], n[2]) {
+		orders = append(orders, "year/month/day")
+	}
+	return orders
+}
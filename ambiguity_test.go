@@ -0,0 +1,54 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAmbiguity(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"01/02/03", "01/02/03 may be month/day/year or day/month/year or year/month/day"},
+		{"01/02/2024 10:00", "01/02/2024 may be month/day/year or day/month/year"},
+		{"01.02.2024, cst", "01.02.2024 may be month/day/year or day/month/year; CST may be Central Standard Time (North America), China or Cuba Standard Time"},
+		{"2023", "2023 may be a year or the time 20:23"},
+		{"10:00 IST", "IST may be India, Israel or Irish Standard Time"},
+		{"1999", ""},
+		{"2023 jan 5", ""},
+		{"2024-01-15", ""},
+		{"01/01/2024", ""},
+		{"13/01/2024", ""},
+		{"10:00 EST", ""},
+	}
+	for _, tt := range tests {
+		if got := ambiguity(tt.input); got != tt.want {
+			t.Errorf("ambiguity(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseBestEffortAmbiguous(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	r, err := ParseBestEffort("01/02/03", Rel(base))
+	if err != nil {
+		t.Fatalf("ParseBestEffort: %v", err)
+	}
+	if !r.Ambiguous || r.Ambiguity == "" {
+		t.Errorf("ParseBestEffort(\"01/02/03\") not flagged as ambiguous")
+	}
+
+	r, err = ParseBestEffort("2024-01-15 10:30 IST?", Rel(base))
+	if err != nil {
+		t.Fatalf("ParseBestEffort: %v", err)
+	}
+	if !r.Ambiguous || r.Remainder != "?" {
+		t.Errorf("ParseBestEffort(\"2024-01-15 10:30 IST?\") = %+v, want an ambiguous zone", r)
+	}
+
+	if r, _ := ParseBestEffort("2024-01-15", Rel(base)); r.Ambiguous {
+		t.Errorf("ParseBestEffort(\"2024-01-15\") flagged as ambiguous: %s", r.Ambiguity)
+	}
+}
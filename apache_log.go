@@ -0,0 +1,103 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseBracketedLogTime parses the bracketed timestamps of Apache and Nginx
+// logs: the error log's "[Fri Sep 09 10:42:29.902022 2011]", with or
+// without the microseconds, and the access log's "[10/Oct/2000:13:55:36
+// -0700]". The error log has no zone, so its time is read in loc. The day
+// of the week is checked to be a valid name but is not used, the date being
+// authoritative.
+func parseBracketedLogTime(str string, loc *time.Location) (time.Time, bool) {
+	if len(str) < 2 || str[0] != '[' || str[len(str)-1] != ']' {
+		return time.Time{}, false
+	}
+	inner := str[1 : len(str)-1]
+	if len(inner) > 0 && isDigit(inner[0]) {
+		return parseAccessLogTime(inner)
+	}
+	return parseErrorLogTime(inner, loc)
+}
+
+// parseErrorLogTime parses "Fri Sep 09 10:42:29.902022 2011".
+func parseErrorLogTime(s string, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), s)
+	if len(fields) != 5 || getDayOfWeek(fields[0]) < 0 {
+		return time.Time{}, false
+	}
+	month, ok := getMonthByName(fields[1])
+	if !ok || len(fields[2]) > 2 || !isAllDigits(fields[2]) || len(fields[4]) != 4 || !isAllDigits(fields[4]) {
+		return time.Time{}, false
+	}
+	year, day := digitsValue(fields[4]), digitsValue(fields[2])
+	hour, minute, second, nanos, ok := readLogClock(fields[3], 0)
+	if !ok || !IsValidDate(year, int(month), day) {
+		return time.Time{}, false
+	}
+	return time.Date(year, month, day, hour, minute, second, nanos, loc), true
+}
+
+// parseAccessLogTime parses "10/Oct/2000:13:55:36 -0700".
+func parseAccessLogTime(s string) (time.Time, bool) {
+	stamp, zone, ok := strings.Cut(s, " ")
+	if !ok || len(stamp) < 12 || stamp[2] != '/' || stamp[6] != '/' || stamp[11] != ':' {
+		return time.Time{}, false
+	}
+	month, ok := getMonthByName(stamp[3:6])
+	if !ok || !isAllDigits(stamp[:2]) || !isAllDigits(stamp[7:11]) {
+		return time.Time{}, false
+	}
+	year, day := digitsValue(stamp[7:11]), digitsValue(stamp[:2])
+	hour, minute, second, nanos, ok := readLogClock(stamp, 12)
+	if !ok || !IsValidDate(year, int(month), day) {
+		return time.Time{}, false
+	}
+	tz, n, ok := parseNumericTimezoneOffset(zone)
+	if !ok || n != len(zone) || zone[0] == 'z' {
+		return time.Time{}, false
+	}
+	return time.Date(year, month, day, hour, minute, second, nanos, tz), true
+}
+
+// readLogClock reads "hh:mm:ss" with an optional fraction of up to nine
+// digits, from s[i:] to the end of s.
+func readLogClock(s string, i int) (hour, minute, second, nanos int, ok bool) {
+	if hour, i, ok = readDigitField(s, i, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, 0, false
+	}
+	if minute, i, ok = readDigitField(s, i+1, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, 0, false
+	}
+	if second, i, ok = readDigitField(s, i+1, 2, 2); !ok || !IsValidTime(hour, minute, second) {
+		return 0, 0, 0, 0, false
+	}
+	if i < len(s) {
+		frac := s[i+1:]
+		if s[i] != '.' || frac == "" || len(frac) > 9 || !isAllDigits(frac) {
+			return 0, 0, 0, 0, false
+		}
+		nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+	}
+	return hour, minute, second, nanos, true
+}
+
+func parseBracketedLogTimeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseBracketedLogTime(str, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.SetFraction(float64(t.Nanosecond()) / 1e9)
+	if isDigit(str[1]) {
+		_, offset := t.Zone()
+		pd.SetTZOffset(t.Location(), offset)
+	}
+	pd.setMaterialized(t)
+	return true
+}
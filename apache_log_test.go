@@ -0,0 +1,49 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBracketedLogTimes(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	minus7 := time.FixedZone("", -7*3600)
+	tests := map[string]time.Time{
+		"[Fri Sep 09 10:42:29.902022 2011]": time.Date(2011, 9, 9, 10, 42, 29, 902022000, time.UTC),
+		"[Mon Oct 10 13:55:36 2000]":        time.Date(2000, 10, 10, 13, 55, 36, 0, time.UTC),
+		"[Sun Jan  5 03:04:05 2025]":        time.Date(2025, 1, 5, 3, 4, 5, 0, time.UTC),
+		"[10/Oct/2000:13:55:36 -0700]":      time.Date(2000, 10, 10, 13, 55, 36, 0, minus7),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) || got.Nanosecond() != want.Nanosecond() {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"[Fri Sep 31 10:42:29 2011]",
+		"[Fri Sep 09 10:42:29. 2011]",
+		"[Xyz Sep 09 10:42:29 2011]",
+		"[10/Oct/2000:13:55:36]",
+		"[10/Oct/2000:25:55:36 -0700]",
+		"[Fri Sep 09 10:42:29 2011",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+
+	lp := NewLineParser(InTZ(time.UTC))
+	tm, rest, err := lp.ParseLine("[Fri Sep 09 10:42:29.902022 2011] [core:error] [pid 35708] File does not exist")
+	if err != nil || !tm.Equal(tests["[Fri Sep 09 10:42:29.902022 2011]"]) || !strings.HasPrefix(rest, "[core:error]") {
+		t.Errorf("ParseLine = %v, %q, %v", tm, rest, err)
+	}
+}
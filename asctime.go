@@ -0,0 +1,59 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// parseAsctime parses the format of C's asctime and ctime, "Mon Jan  2
+// 15:04:05 2006", in which days below 10 are padded with a space, and that
+// of the Unix date command, which puts a zone before the year: "Mon Jan  2
+// 15:04:05 MST 2006". The day of the week must be the one of the date.
+func parseAsctime(str string, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if len(fields) != 5 && len(fields) != 6 {
+		return time.Time{}, false
+	}
+	weekday := getDayOfWeek(fields[0])
+	month, ok := getMonthByName(fields[1])
+	if weekday < 0 || !ok || len(fields[2]) > 2 || !isAllDigits(fields[2]) {
+		return time.Time{}, false
+	}
+	hour, minute, second, ok := readRFC2822Clock(fields[3])
+	if !ok {
+		return time.Time{}, false
+	}
+	zone := loc
+	if len(fields) == 6 {
+		if zone, ok = readRFC2822Zone(fields[4]); !ok {
+			return time.Time{}, false
+		}
+	}
+	yearField := fields[len(fields)-1]
+	if len(yearField) != 4 || !isAllDigits(yearField) {
+		return time.Time{}, false
+	}
+
+	year, day := digitsValue(yearField), digitsValue(fields[2])
+	if !IsValidDate(year, int(month), day) {
+		return time.Time{}, false
+	}
+	t := time.Date(year, month, day, hour, minute, second, 0, zone)
+	if int(t.Weekday()) != weekday {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func parseAsctimeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseAsctime(str, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	if t.Location() != loc {
+		setTZFromLocation(pd, t.Location(), t)
+	}
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,41 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAsctimeDates(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"Sun Jan 15 10:30:45 2023":     time.Date(2023, 1, 15, 10, 30, 45, 0, time.UTC),
+		"Thu Jan  5 10:30:45 2023":     time.Date(2023, 1, 5, 10, 30, 45, 0, time.UTC),
+		"Thu Jan  1 00:00:00 1970":     time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		"Sun Jan 15 10:30:45 UTC 2023": time.Date(2023, 1, 15, 10, 30, 45, 0, time.UTC),
+		"Sun Jan 15 10:30:45 EST 2023": time.Date(2023, 1, 15, 15, 30, 45, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"sun jan  5 10:30:45 2023",
+		"thu feb 30 10:30:45 2023",
+		"sun jan 15 24:30:45 2023",
+		"sun jan 15 10:30:45 23",
+		"sun jan 15 10:30:45 xyz 2023",
+	} {
+		if got, ok := parseAsctime(input, time.UTC); ok {
+			t.Errorf("parseAsctime(%q) = %v, want no match", input, got)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseResult is the outcome of a best-effort parse.
+type ParseResult struct {
+	Time time.Time
+	// Confidence is the share of the input, not counting whitespace, that
+	// was understood: 1 when all of it was, down to 0 when none was.
+	Confidence float64
+	// Parsed is the start of the input Time was read from, and Remainder
+	// the text after it that could not be made sense of.
+	Parsed, Remainder string
+	// Ambiguous is set when the part read admits more than one plausible
+	// reading, such as "01/02/03", a bare "2023" or "IST", and Ambiguity
+	// then says why, so that the input can be routed for review.
+	Ambiguous bool
+	Ambiguity string
+}
+
+// ParseBestEffort parses str as StrToTime does, but instead of failing on
+// malformed input it reads the longest start of str that makes sense and
+// returns the rest as the Remainder of the result, for data-cleaning
+// pipelines that would rather have a partial reading than none. Of
+// "2023-02-3x 10:??", it reads "2023-02-3" with a confidence of 0.6.
+//
+// The input is only cut where a word, a number or a run of punctuation
+// ends, so that none of them is read in part. When no start of str can be
+// read, the error of StrToTime is returned.
+func ParseBestEffort(str string, opts ...Option) (ParseResult, error) {
+	t, err := StrToTime(str, opts...)
+	if err == nil {
+		return withAmbiguity(ParseResult{Time: t, Confidence: 1, Parsed: str}), nil
+	}
+
+	pt, i, ok := parseLongestPrefix(str, withDefaults(opts))
+	if !ok {
+		return ParseResult{Remainder: strings.TrimSpace(str)}, err
+	}
+	prefix := str[:i]
+	return withAmbiguity(ParseResult{
+		Time:       pt,
+		Confidence: float64(countNonSpace(prefix)) / float64(countNonSpace(str)),
+		Parsed:     strings.TrimSpace(prefix),
+		Remainder:  strings.TrimSpace(str[i:]),
+	}), nil
+}
+
+// StrToTimePrefix parses the longest start of str that StrToTime accepts,
+// for log and text processing pipelines that find a timestamp at the start
+// of a longer string, and returns it along with the number of bytes of str
+// it spans, leading whitespace included: of "2024-01-15 10:30:00 GET
+// /index.html" it reads "2024-01-15 10:30:00", 19 bytes. As with
+// ParseBestEffort, str is only cut where a word, a number or a run of
+// punctuation ends. When no start of str can be read, the error of
+// StrToTime is returned.
+func StrToTimePrefix(str string, opts ...Option) (time.Time, int, error) {
+	t, err := StrToTime(str, opts...)
+	if err == nil {
+		return t, len(strings.TrimRightFunc(str, unicode.IsSpace)), nil
+	}
+	t, i, ok := parseLongestPrefix(str, withDefaults(opts))
+	if !ok {
+		return time.Time{}, 0, err
+	}
+	return t, i, nil
+}
+
+// parseLongestPrefix parses the longest proper prefix of str that ends
+// where a word, a number or a run of punctuation does, and returns the time
+// read and the length of the prefix. Only those token boundaries are tried,
+// never the inside of a token or of a UTF-8 sequence. The attempts are not
+// reported to OnParse hooks.
+func parseLongestPrefix(str string, opts []Option) (time.Time, int, bool) {
+	i := min(len(str)-1, maxInputLen)
+	for i > 0 && !utf8.RuneStart(str[i]) {
+		i--
+	}
+	for i > 0 {
+		prev, size := utf8.DecodeLastRuneInString(str[:i])
+		next, _ := utf8.DecodeRuneInString(str[i:])
+		if c := runeClass(prev); c != classSpace && c != runeClass(next) {
+			if t, err := checkedParse(str[:i], opts, newParsedDate()); err == nil {
+				return t, i, true
+			}
+		}
+		i -= size
+	}
+	return time.Time{}, 0, false
+}
+
+// withAmbiguity sets the ambiguity of r from the part of the input read.
+func withAmbiguity(r ParseResult) ParseResult {
+	r.Ambiguity = ambiguity(r.Parsed)
+	r.Ambiguous = r.Ambiguity != ""
+	return r
+}
+
+// Classes of runes returned by runeClass. A token of the input is a run of
+// runes of the same class.
+const (
+	classSpace = iota
+	classLetter
+	classDigit
+	classOther
+)
+
+// runeClass returns the class of r.
+func runeClass(r rune) int {
+	switch {
+	case unicode.IsSpace(r):
+		return classSpace
+	case unicode.IsLetter(r):
+		return classLetter
+	case r >= '0' && r <= '9':
+		return classDigit
+	}
+	return classOther
+}
+
+// countNonSpace returns the number of characters of s that are not
+// whitespace.
+func countNonSpace(s string) int {
+	n := 0
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,73 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBestEffort(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input             string
+		want              time.Time
+		confidence        float64
+		parsed, remainder string
+	}{
+		{"2024-01-15 10:30", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 1, "2024-01-15 10:30", ""},
+		{"2023-02-3x 10:??", time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC), 0.6, "2023-02-3", "x 10:??"},
+		{"2024-01-15 10:30 blah", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 15.0 / 19, "2024-01-15 10:30", "blah"},
+		{"jan 5 2024 (approx)", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), 0.5, "jan 5 2024", "(approx)"},
+		{"2024-01-15 10:35?x", time.Date(2024, 1, 15, 10, 35, 0, 0, time.UTC), 15.0 / 17, "2024-01-15 10:35", "?x"},
+		{"2024-01-15日本語", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 10.0 / 13, "2024-01-15", "日本語"},
+		{"2024-01-15 10:30 → déjà vu", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 15.0 / 22, "2024-01-15 10:30", "→ déjà vu"},
+	}
+	for _, tt := range tests {
+		got, err := ParseBestEffort(tt.input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("ParseBestEffort(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want) || got.Confidence != tt.confidence || got.Parsed != tt.parsed || got.Remainder != tt.remainder {
+			t.Errorf("ParseBestEffort(%q) = %v %v %q %q, want %v %v %q %q", tt.input,
+				got.Time, got.Confidence, got.Parsed, got.Remainder, tt.want, tt.confidence, tt.parsed, tt.remainder)
+		}
+	}
+
+	got, err := ParseBestEffort("??:??", Rel(base))
+	if err == nil {
+		t.Errorf("ParseBestEffort(\"??:??\") = %v, want an error", got.Time)
+	}
+	if got.Confidence != 0 || got.Remainder != "??:??" {
+		t.Errorf("ParseBestEffort(\"??:??\") = %v %q, want 0 and the whole input", got.Confidence, got.Remainder)
+	}
+}
+
+func TestStrToTimePrefix(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    string
+		want     time.Time
+		consumed int
+	}{
+		{"2024-01-15 10:30:00 GET /index.html", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 19},
+		{"  2024-01-15T10:30:00Z: started", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 22},
+		{"Jan 15 10:30:00 host sshd[123]: accepted", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 15},
+		{"[15/Jan/2024:10:30:45 +0000] GET", time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC), 28},
+		{"tomorrow at noon we meet", time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC), 16},
+		{"next friday ", time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC), 11},
+		{"2024-01-15 10:30—ok", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 16},
+		{"2024-01-15 10:30 ünïcödé", time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), 16},
+	}
+	for _, tt := range tests {
+		got, n, err := StrToTimePrefix(tt.input, Rel(base))
+		if err != nil || !got.Equal(tt.want) || n != tt.consumed {
+			t.Errorf("StrToTimePrefix(%q) = %v, %d, %v, want %v, %d", tt.input, got, n, err, tt.want, tt.consumed)
+		}
+	}
+
+	if _, n, err := StrToTimePrefix("hello 2024", Rel(base)); err == nil || n != 0 {
+		t.Errorf("StrToTimePrefix(\"hello 2024\") = %d, %v, want an error", n, err)
+	}
+}
@@ -0,0 +1,118 @@
+package strtotime
+
+import "time"
+
+// The boundary helpers return the first or last second of the day, week,
+// month, quarter or year containing t, as "start of week" and "end of
+// month" do: starts are midnight on the first day (or the end of a DST gap
+// skipping midnight) and ends 23:59:59 on the last one. They read the InTZ
+// option, which moves t to that zone first, and WeekStartsOn; other options
+// are ignored.
+
+// StartOfDay returns midnight at the start of the day of t.
+func StartOfDay(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	y, m, d := t.Date()
+	return startOfDate(y, m, d, t.Location())
+}
+
+// EndOfDay returns 23:59:59 on the day of t.
+func EndOfDay(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	y, m, d := t.Date()
+	return endOfDate(y, m, d, t.Location())
+}
+
+// StartOfWeek returns midnight on the first day of the week of t, Monday
+// unless WeekStartsOn says otherwise.
+func StartOfWeek(t time.Time, opts ...Option) time.Time {
+	t, weekStart := boundaryTime(t, opts)
+	y, m, d := weekStartDate(t, weekStart)
+	return startOfDate(y, m, d, t.Location())
+}
+
+// EndOfWeek returns 23:59:59 on the last day of the week of t.
+func EndOfWeek(t time.Time, opts ...Option) time.Time {
+	t, weekStart := boundaryTime(t, opts)
+	y, m, d := weekStartDate(t, weekStart)
+	return endOfDate(y, m, d+6, t.Location())
+}
+
+// StartOfMonth returns midnight on the first day of the month of t.
+func StartOfMonth(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return startOfDate(t.Year(), t.Month(), 1, t.Location())
+}
+
+// EndOfMonth returns 23:59:59 on the last day of the month of t.
+func EndOfMonth(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return endOfDate(t.Year(), t.Month()+1, 0, t.Location())
+}
+
+// StartOfQuarter returns midnight on the first day of the calendar quarter
+// of t: January 1st, April 1st, July 1st or October 1st.
+func StartOfQuarter(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return startOfDate(t.Year(), quarterStartMonth(t.Month()), 1, t.Location())
+}
+
+// EndOfQuarter returns 23:59:59 on the last day of the calendar quarter of
+// t.
+func EndOfQuarter(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return endOfDate(t.Year(), quarterStartMonth(t.Month())+3, 0, t.Location())
+}
+
+// StartOfYear returns midnight on January 1st of the year of t.
+func StartOfYear(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return startOfDate(t.Year(), time.January, 1, t.Location())
+}
+
+// EndOfYear returns 23:59:59 on December 31st of the year of t.
+func EndOfYear(t time.Time, opts ...Option) time.Time {
+	t, _ = boundaryTime(t, opts)
+	return endOfDate(t.Year(), time.December, 31, t.Location())
+}
+
+// boundaryTime returns t in the zone set with InTZ, if any, and the first
+// day of the week set with WeekStartsOn. Defaults set by SetDefaultOptions
+// apply, as they do to StrToTime.
+func boundaryTime(t time.Time, opts []Option) (time.Time, time.Weekday) {
+	opts = withDefaults(opts)
+	for _, opt := range opts {
+		if o, ok := opt.(tzOption); ok && o.loc != nil {
+			t = t.In(o.loc)
+		}
+	}
+	return t, resolveSettings(opts).weekStart
+}
+
+// weekStartDate returns the date of the first day of the week of t, for
+// weeks starting on weekStart. The day may be out of range, as time.Date
+// accepts.
+func weekStartDate(t time.Time, weekStart time.Weekday) (int, time.Month, int) {
+	y, m, d := t.Date()
+	return y, m, d - daysSinceWeekStart(t.Weekday(), weekStart)
+}
+
+// quarterStartMonth returns the first month of the calendar quarter of m.
+func quarterStartMonth(m time.Month) time.Month {
+	return m - (m-1)%3
+}
+
+// startOfDate returns midnight in loc on the given date, which time.Date
+// normalizes. On days where a DST change skips midnight, the day starts at
+// the end of the gap, as in PHP.
+func startOfDate(y int, m time.Month, d int, loc *time.Location) time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	y, m, d = time.Date(y, m, d, 12, 0, 0, 0, loc).Date()
+	return fixDSTGap(t, y, m, d)
+}
+
+// endOfDate returns 23:59:59 in loc on the given date, which time.Date
+// normalizes.
+func endOfDate(y int, m time.Month, d int, loc *time.Location) time.Time {
+	return time.Date(y, m, d, 23, 59, 59, 0, loc)
+}
@@ -0,0 +1,53 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBoundaries(t *testing.T) {
+	tm := time.Date(2024, 5, 15, 13, 45, 10, 500, time.UTC) // a Wednesday
+	const layout = "2006-01-02 15:04:05"
+	tests := []struct {
+		name string
+		fn   func(time.Time, ...Option) time.Time
+		opts []Option
+		want string
+	}{
+		{"StartOfDay", StartOfDay, nil, "2024-05-15 00:00:00"},
+		{"EndOfDay", EndOfDay, nil, "2024-05-15 23:59:59"},
+		{"StartOfWeek", StartOfWeek, nil, "2024-05-13 00:00:00"},
+		{"StartOfWeek/Sunday", StartOfWeek, []Option{WeekStartsOn(time.Sunday)}, "2024-05-12 00:00:00"},
+		{"StartOfWeek/Thursday", StartOfWeek, []Option{WeekStartsOn(time.Thursday)}, "2024-05-09 00:00:00"},
+		{"EndOfWeek", EndOfWeek, nil, "2024-05-19 23:59:59"},
+		{"EndOfWeek/Sunday", EndOfWeek, []Option{WeekStartsOn(time.Sunday)}, "2024-05-18 23:59:59"},
+		{"StartOfMonth", StartOfMonth, nil, "2024-05-01 00:00:00"},
+		{"EndOfMonth", EndOfMonth, nil, "2024-05-31 23:59:59"},
+		{"StartOfQuarter", StartOfQuarter, nil, "2024-04-01 00:00:00"},
+		{"EndOfQuarter", EndOfQuarter, nil, "2024-06-30 23:59:59"},
+		{"StartOfYear", StartOfYear, nil, "2024-01-01 00:00:00"},
+		{"EndOfYear", EndOfYear, nil, "2024-12-31 23:59:59"},
+		{"StartOfDay/Tokyo", StartOfDay, []Option{InTZ(time.FixedZone("JST", 9*3600))}, "2024-05-15 00:00:00"},
+		{"EndOfDay/Tokyo", EndOfDay, []Option{InTZ(time.FixedZone("JST", 11*3600))}, "2024-05-16 23:59:59"},
+	}
+	for _, tt := range tests {
+		if got := tt.fn(tm, tt.opts...).Format(layout); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if got := EndOfMonth(time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)); got.Day() != 29 {
+		t.Errorf("EndOfMonth in February 2024 = %v, want the 29th", got)
+	}
+
+	// DST started at midnight on November 4th, 2018 in São Paulo: the day
+	// started at 01:00.
+	sp, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip("America/Sao_Paulo not available")
+	}
+	got := StartOfDay(time.Date(2018, 11, 4, 15, 0, 0, 0, sp))
+	if got.Day() != 4 || got.Hour() != 1 {
+		t.Errorf("StartOfDay across a DST gap = %v, want 2018-11-04 01:00", got)
+	}
+}
@@ -0,0 +1,221 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// maxBusinessDaySteps bounds the days walked by a business-day expression,
+// so that a calendar without working days cannot loop forever.
+const maxBusinessDaySteps = 100000
+
+// parseBusinessDayBase parses the date after "from", "after" or "before".
+// It is parseToTime, assigned in init because parseToTime reaches
+// parseBusinessDays through formatParsers.
+var parseBusinessDayBase func(string, []Option, *ParsedDate) (time.Time, error)
+
+func init() {
+	parseBusinessDayBase = parseToTime
+}
+
+// Weekend sets the days of the week that are not business days in
+// expressions such as "3 business days from now" or "next working day"
+// (default: Saturday and Sunday). It does not change PHP's "weekday" unit,
+// which always skips Saturday and Sunday.
+func Weekend(days ...time.Weekday) Option {
+	o := weekendOption{}
+	for _, d := range days {
+		o.days |= 1 << d
+	}
+	return o
+}
+
+// weekendOption is an internal type for the Weekend option, with one bit
+// per day of the week.
+type weekendOption struct {
+	days uint8
+}
+
+func (weekendOption) isOption() bool {
+	return true
+}
+
+// BusinessHolidays sets the holidays that are not business days, in
+// addition to the weekend: those of cals that are not marked WorkingDay. By
+// default business days only skip the weekend.
+func BusinessHolidays(cals ...HolidayCalendar) Option {
+	return businessHolidaysOption{cals: cals}
+}
+
+// businessHolidaysOption is an internal type for the BusinessHolidays option
+type businessHolidaysOption struct {
+	cals []HolidayCalendar
+}
+
+func (businessHolidaysOption) isOption() bool {
+	return true
+}
+
+// businessCalendar tells business days from weekends and holidays.
+type businessCalendar struct {
+	weekend  uint8
+	cals     []HolidayCalendar
+	holidays map[int]map[[2]int]bool // per year, month and day of the holidays
+}
+
+// newBusinessCalendar returns the businessCalendar selected by opts.
+func newBusinessCalendar(opts []Option) *businessCalendar {
+	bc := &businessCalendar{weekend: 1<<time.Saturday | 1<<time.Sunday}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case weekendOption:
+			bc.weekend = o.days
+		case businessHolidaysOption:
+			bc.cals = o.cals
+		}
+	}
+	return bc
+}
+
+// isBusinessDay reports whether the day of t is neither a weekend day nor
+// a holiday.
+func (bc *businessCalendar) isBusinessDay(t time.Time) bool {
+	if bc.weekend&(1<<t.Weekday()) != 0 {
+		return false
+	}
+	if len(bc.cals) == 0 {
+		return true
+	}
+	days, ok := bc.holidays[t.Year()]
+	if !ok {
+		days = make(map[[2]int]bool)
+		for _, cal := range bc.cals {
+			for _, h := range cal.Holidays(t.Year()) {
+				if !h.WorkingDay {
+					days[[2]int{int(h.Month), h.Day}] = true
+				}
+			}
+		}
+		if bc.holidays == nil {
+			bc.holidays = make(map[int]map[[2]int]bool)
+		}
+		bc.holidays[t.Year()] = days
+	}
+	return !days[[2]int{int(t.Month()), t.Day()}]
+}
+
+// add returns t moved by n business days, forward or backward depending on
+// the sign of n. The time of day is kept. It returns false when no business
+// day is found within maxBusinessDaySteps days.
+func (bc *businessCalendar) add(t time.Time, n int) (time.Time, bool) {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	y, m, d := t.Date()
+	hour, minute, sec := t.Clock()
+	for steps := 0; n > 0; steps++ {
+		if steps == maxBusinessDaySteps {
+			return time.Time{}, false
+		}
+		d += step
+		if bc.isBusinessDay(time.Date(y, m, d, 12, 0, 0, 0, t.Location())) {
+			n--
+		}
+	}
+	return time.Date(y, m, d, hour, minute, sec, t.Nanosecond(), t.Location()), true
+}
+
+// parseBusinessDays parses business-day arithmetic: "3 business days",
+// "+2 working days", "3 business days from now", "2 working days before
+// 2024-03-01", "5 business days ago", "next business day" and "last business
+// day". Counts keep the time of day of their base, which is now unless a
+// date follows "from", "after" or "before"; "next" and "last" give midnight.
+// The weekend and the holidays skipped are set with the Weekend and
+// BusinessHolidays options.
+//
+// ok reports whether str is business-day arithmetic; inRange is false when
+// it is but the result can't be reached: a count of more than four digits,
+// or a calendar without business days.
+func parseBusinessDays(str string, now time.Time, loc *time.Location, opts []Option) (t time.Time, ok, inRange bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if len(fields) < 3 {
+		return time.Time{}, false, false
+	}
+	bc := newBusinessCalendar(opts)
+
+	switch fields[0] {
+	case DirectionNext, DirectionLast, "previous":
+		if len(fields) != 3 || !isBusinessDayUnit(fields[1], fields[2], false) {
+			return time.Time{}, false, false
+		}
+		y, m, d := now.In(loc).Date()
+		n := 1
+		if fields[0] != DirectionNext {
+			n = -1
+		}
+		t, inRange := bc.add(time.Date(y, m, d, 0, 0, 0, 0, loc), n)
+		return t, true, inRange
+	}
+
+	num, sign := fields[0], 1
+	if num[0] == '+' || num[0] == '-' {
+		if num[0] == '-' {
+			sign = -1
+		}
+		num = num[1:]
+	}
+	if num == "" || !isAllDigits(num) || !isBusinessDayUnit(fields[1], fields[2], true) {
+		return time.Time{}, false, false
+	}
+	if len(num) > 4 {
+		return time.Time{}, true, false
+	}
+	n := sign * digitsValue(num)
+
+	base := now.In(loc)
+	switch rest := fields[3:]; {
+	case len(rest) == 0:
+	case len(rest) == 1 && rest[0] == "ago":
+		n = -n
+	case len(rest) > 1 && (rest[0] == "from" || rest[0] == "after" || rest[0] == "before"):
+		if rest[0] == "before" {
+			n = -n
+		}
+		t, err := parseBusinessDayBase(strings.Join(rest[1:], " "), opts, newParsedDate())
+		if err != nil {
+			return time.Time{}, false, false
+		}
+		base = t
+	default:
+		return time.Time{}, false, false
+	}
+	t, inRange = bc.add(base, n)
+	return t, true, inRange
+}
+
+// isBusinessDayUnit reports whether kind and unit name business days:
+// "business day" or "working day", and their plurals when plural is set.
+func isBusinessDayUnit(kind, unit string, plural bool) bool {
+	return (kind == "business" || kind == "working") &&
+		(unit == UnitDay || (plural && unit == "days"))
+}
+
+func parseBusinessDaysInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok, inRange := parseBusinessDays(str, now, loc, opts)
+	if !ok {
+		return false
+	}
+	if !inRange {
+		// An error rather than a miss, so that Lenient doesn't read the
+		// input as something else.
+		pd.AddError(0, "business days out of range")
+		return true
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,77 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessDays(t *testing.T) {
+	base := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC) // a Friday
+
+	tests := []struct {
+		input string
+		opts  []Option
+		want  string
+	}{
+		{"3 business days", nil, "2024-03-06 10:00:00"},
+		{"3 business days from now", nil, "2024-03-06 10:00:00"},
+		{"+1 working day", nil, "2024-03-04 10:00:00"},
+		{"-1 business day", nil, "2024-02-29 10:00:00"},
+		{"5 business days ago", nil, "2024-02-23 10:00:00"},
+		{"next business day", nil, "2024-03-04 00:00:00"},
+		{"last working day", nil, "2024-02-29 00:00:00"},
+		{"2 working days before 2024-03-01", nil, "2024-02-28 00:00:00"},
+		{"1 business day after 2024-03-02 15:00", nil, "2024-03-04 15:00:00"},
+		{"0 business days", nil, "2024-03-01 10:00:00"},
+
+		// A Friday-Saturday weekend.
+		{"next business day", []Option{Weekend(time.Friday, time.Saturday)}, "2024-03-03 00:00:00"},
+		{"2 business days before 2024-03-03", []Option{Weekend(time.Friday, time.Saturday)}, "2024-02-28 00:00:00"},
+
+		// Good Friday and Easter Monday 2024 fall on March 29th and April 1st.
+		{"1 business day after 2024-03-28", nil, "2024-03-29 00:00:00"},
+		{"1 business day after 2024-03-28", []Option{BusinessHolidays(WesternHolidays)}, "2024-04-02 00:00:00"},
+		{"next business day", []Option{BusinessHolidays(UKHolidays)}, "2024-03-04 00:00:00"},
+		{"3 working days before 2024-12-27", []Option{BusinessHolidays(WesternHolidays, UKHolidays)}, "2024-12-20 00:00:00"},
+	}
+	for _, tt := range tests {
+		opts := append([]Option{Rel(base), InTZ(time.UTC)}, tt.opts...)
+		got, err := StrToTime(tt.input, opts...)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != tt.want {
+			t.Errorf("%q = %s, want %s", tt.input, s, tt.want)
+		}
+	}
+
+	allWeekend := Weekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	for _, input := range []string{"next business days", "3 business days from nowhere", "2 business weeks"} {
+		if got, err := StrToTime(input, Rel(base), InTZ(time.UTC)); err == nil {
+			t.Errorf("%q = %s, want an error", input, got)
+		}
+	}
+	if got, err := StrToTime("next business day", Rel(base), allWeekend); err == nil {
+		t.Errorf("next business day without business days = %s, want an error", got)
+	}
+
+	// Counts out of range fail in every mode instead of being read as
+	// something else.
+	for _, mode := range []Option{Rel(base), Lenient(), Rollover()} {
+		for _, tt := range []struct {
+			input string
+			opts  []Option
+		}{
+			{"+999999 business days", nil},
+			{"next business day", []Option{allWeekend}},
+		} {
+			opts := append([]Option{Rel(base), mode}, tt.opts...)
+			if got, err := StrToTime(tt.input, opts...); err == nil {
+				t.Errorf("%q with %T = %s, want an error", tt.input, mode, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package strtotime
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache memoizes the results of StrToTime for workloads that parse the same
+// literal strings over and over, such as constant header values. Only inputs
+// that name a full date in a fixed format, whose result can't depend on the
+// reference time, are cached: "2024-01-15 10:00" or "Mon, 15 Jan 2024
+// 10:30:00 +0000" are, while "today", "next monday" or "+1 hour" are parsed
+// on every call, as are failed parses.
+// Entries are keyed by the input, the parsing timezone and the other
+// options, and the least recently used entry is evicted once the cache is
+// full. Inputs parsed with an option the key cannot hold are parsed on every
+// call.
+//
+// A cached result is the one StrToTime returns for the same input and
+// options at any reference time.
+//
+// A Cache is safe for concurrent use.
+type Cache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List // front is most recently used
+	items map[cacheKey]*list.Element
+}
+
+// cacheKey identifies a cached parse.
+type cacheKey struct {
+	input string
+	loc   *time.Location
+	cfg   parseSettings
+	ext   string // the options of extended builds, see extendedCacheKey
+}
+
+// cacheEntry is the value stored for a cacheKey.
+type cacheEntry struct {
+	key cacheKey
+	t   time.Time
+}
+
+// NewCache returns a Cache holding up to size entries.
+func NewCache(size int) *Cache {
+	if size < 1 {
+		size = 1
+	}
+	return &Cache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[cacheKey]*list.Element),
+	}
+}
+
+// StrToTime parses str like the package-level StrToTime, returning a cached
+// result when the same input was already parsed with the same options and
+// does not depend on the reference time.
+func (c *Cache) StrToTime(str string, opts ...Option) (time.Time, error) {
+	opts = withDefaults(opts)
+	ext, ok := extendedCacheKey(opts)
+	if !ok {
+		return strToTime(str, opts, newParsedDate())
+	}
+	_, loc := resolveOptions(opts)
+	key := cacheKey{input: str, loc: loc, cfg: resolveSettings(opts), ext: ext}
+
+	c.mu.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		t := el.Value.(*cacheEntry).t
+		c.mu.Unlock()
+		return t, nil
+	}
+	c.mu.Unlock()
+
+	pd := newParsedDate()
+	t, err := strToTime(str, opts, pd)
+	if err != nil || !pd.independentOfNow() {
+		return t, err
+	}
+
+	c.mu.Lock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+	} else {
+		c.items[key] = c.ll.PushFront(&cacheEntry{key: key, t: t})
+		if c.ll.Len() > c.size {
+			old := c.ll.Back()
+			c.ll.Remove(old)
+			delete(c.items, old.Value.(*cacheEntry).key)
+		}
+	}
+	c.mu.Unlock()
+	return t, nil
+}
+
+// Len returns the number of entries currently held by the cache.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// pinReference returns opts with an additional Rel option for ref. Later
+// options take precedence, and opts itself is left untouched.
+func pinReference(opts []Option, ref time.Time) []Option {
+	return append(opts[:len(opts):len(opts)], Rel(ref))
+}
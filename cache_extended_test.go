@@ -0,0 +1,51 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheExtendedOptions(t *testing.T) {
+	thursday := Rel(time.Date(2024, 6, 13, 10, 0, 0, 0, time.UTC))
+	tests := []struct {
+		input string
+		opts  []Option
+		want  time.Time // with opts; the zero time for an error
+		plain time.Time // without them
+		held  int       // entries the cache ends up with
+	}{
+		{"fy2024", []Option{FiscalYearStart(time.October)},
+			time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+		{"next business day", []Option{Weekend(time.Friday, time.Saturday)},
+			time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC), 0},
+		{"4 business days", []Option{BusinessHolidays(USHolidays)},
+			time.Date(2024, 6, 20, 10, 0, 0, 0, time.UTC), time.Date(2024, 6, 19, 10, 0, 0, 0, time.UTC), 0},
+		{"lundi prochain", []Option{WithLocale(FrenchLocale)},
+			time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC), time.Time{}, 0},
+		{"15 mars 2024", []Option{WithLocale(FrenchLocale)},
+			time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Time{}, 1},
+		{"1403-01-15", []Option{InCalendar(PersianCalendar)},
+			time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC), time.Date(1403, 1, 15, 0, 0, 0, 0, time.UTC), 1},
+	}
+	for _, tt := range tests {
+		c := NewCache(8)
+		for i, want := range []time.Time{tt.want, tt.plain, tt.want} {
+			opts := []Option{thursday, InTZ(time.UTC)}
+			if i != 1 {
+				opts = append(opts, tt.opts...)
+			}
+			got, err := c.StrToTime(tt.input, opts...)
+			switch {
+			case want.IsZero() && err == nil:
+				t.Errorf("StrToTime(%q) #%d = %v, want an error", tt.input, i, got)
+			case !want.IsZero() && (err != nil || !got.Equal(want)):
+				t.Errorf("StrToTime(%q) #%d = %v, %v, want %v", tt.input, i, got, err, want)
+			}
+		}
+		if c.Len() != tt.held {
+			t.Errorf("StrToTime(%q): Len() = %d, want %d", tt.input, c.Len(), tt.held)
+		}
+	}
+}
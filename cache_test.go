@@ -0,0 +1,122 @@
+package strtotime
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	c := NewCache(2)
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		input string
+		base  time.Time
+		want  time.Time
+	}{
+		{"today", base, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"today", base.Add(3 * time.Hour), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"today", base.Add(24 * time.Hour), time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"now", base, base},
+		{"now", base.Add(time.Minute), base.Add(time.Minute)},
+		{"+1 hour", base.Add(time.Minute), base.Add(61 * time.Minute)},
+		{"2024-01-15 10:00 +0200", base, time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)},
+		{"2024-01-15 10:00 +0200", base.Add(time.Hour), time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		got, err := c.StrToTime(tc.input, Rel(tc.base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", tc.input, err)
+			continue
+		}
+		if !got.Equal(tc.want) {
+			t.Errorf("StrToTime(%q) at %v = %v, want %v", tc.input, tc.base, got, tc.want)
+		}
+	}
+	// Only the input that names a full date is held.
+	if n := c.Len(); n != 1 {
+		t.Errorf("Len() = %d, want 1", n)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.StrToTime("hello world", Rel(base)); err == nil {
+			t.Error("expected an error for invalid input")
+		}
+	}
+	if n := c.Len(); n != 1 {
+		t.Errorf("Len() after an error = %d, want 1", n)
+	}
+
+	// Different timezones must not share entries.
+	paris, _ := loadLocation("Europe/Paris")
+	got, _ := c.StrToTime("today", Rel(base), InTZ(paris))
+	if want := time.Date(2024, 3, 1, 0, 0, 0, 0, paris); !got.Equal(want) {
+		t.Errorf("StrToTime(today, Paris) = %v, want %v", got, want)
+	}
+}
+
+func TestCacheReferenceIndependent(t *testing.T) {
+	// Inputs whose date comes from the reference time are never held, even
+	// when the parser fills in a full date.
+	c := NewCache(8)
+	for _, input := range []string{"tomorrow 10am +1 hour", "next month + 2 weeks", "last day of next month", "monday next week"} {
+		for _, ref := range []time.Time{
+			time.Date(2024, 3, 10, 12, 34, 56, 0, time.UTC),
+			time.Date(1999, 11, 30, 3, 2, 1, 0, time.UTC),
+		} {
+			want, err := StrToTime(input, Rel(ref))
+			if err != nil {
+				t.Fatalf("StrToTime(%q) error: %v", input, err)
+			}
+			if got, err := c.StrToTime(input, Rel(ref)); err != nil || !got.Equal(want) {
+				t.Errorf("Cache.StrToTime(%q) at %v = %v, %v, want %v", input, ref, got, err, want)
+			}
+		}
+	}
+	if n := c.Len(); n != 0 {
+		t.Errorf("Len() = %d, want 0", n)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := NewCache(8)
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				got, err := c.StrToTime("next monday", Rel(base))
+				if err != nil || !got.Equal(want) {
+					t.Errorf("StrToTime(next monday) = %v, %v", got, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCacheOptions(t *testing.T) {
+	c := NewCache(8)
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	// The same input parsed with two option sets must not share an entry.
+	for _, tc := range []struct {
+		opts []Option
+		want time.Time
+	}{
+		{[]Option{base, DayFirst(true)}, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{[]Option{base}, time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{[]Option{base, DayFirst(true)}, time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)},
+	} {
+		got, err := c.StrToTime("03/04/2023", tc.opts...)
+		if err != nil || !got.Equal(tc.want) {
+			t.Errorf("StrToTime(03/04/2023) = %v, %v, want %v", got, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar is a calendar system other than the Gregorian one, in which
+// dates such as "15 Farvardin 1403" or "10 Tishrei 5785" can be written.
+// PersianCalendar, HebrewCalendar and HijriCalendar are built in; others
+// can be added with RegisterCalendar or selected with InCalendar.
+type Calendar interface {
+	// Month returns the number of the month named name, given in lowercase,
+	// without apostrophes and with words separated by single spaces, or
+	// false when name is not one of its months.
+	Month(name string) (int, bool)
+	// Gregorian returns the Gregorian date of day of month of year, or
+	// false when there is no such date.
+	Gregorian(year, month, day int) (int, time.Month, int, bool)
+}
+
+// calendars are the calendars whose month names are recognized, in order.
+var calendars = []Calendar{PersianCalendar, HebrewCalendar, HijriCalendar}
+
+// RegisterCalendar adds cal to the calendars whose month names are
+// recognized. Calendars are searched from the most recently registered one
+// to the built-in ones.
+//
+// Like RegisterHolidayCalendar, this is not guarded against concurrent use:
+// register calendars from an init function, before any parsing takes place.
+func RegisterCalendar(cal Calendar) {
+	calendars = append([]Calendar{cal}, calendars...)
+}
+
+// InCalendar reads dates in cal: numeric dates written year first
+// ("1403-01-15", "1403/1/15") are dates of cal rather than Gregorian ones,
+// and only the month names of cal are recognized among those of the other
+// calendars. Gregorian month names are still understood.
+func InCalendar(cal Calendar) Option {
+	return calendarOption{cal: cal}
+}
+
+// calendarOption is an internal type for the InCalendar option
+type calendarOption struct {
+	cal Calendar
+}
+
+func (calendarOption) isOption() bool {
+	return true
+}
+
+// selectedCalendar returns the calendar set with InCalendar, or nil.
+func selectedCalendar(opts []Option) Calendar {
+	var cal Calendar
+	for _, opt := range opts {
+		if o, ok := opt.(calendarOption); ok {
+			cal = o.cal
+		}
+	}
+	return cal
+}
+
+// parseCalendarTime parses the time following a date, assigned in init
+// because parseToTime reaches parseCalendarDateInto through formatParsers.
+var parseCalendarTime func(string, []Option, *ParsedDate) (time.Time, error)
+
+func init() {
+	parseCalendarTime = parseToTime
+}
+
+// parseCalendarDate parses a date of another calendar with its month
+// named, "15 farvardin 1403" or "farvardin 15, 1403", optionally followed
+// by a time. The month names of the calendar set with InCalendar are
+// recognized, or those of every registered calendar.
+func parseCalendarDate(str string, now time.Time, loc *time.Location, opts []Option) (time.Time, bool) {
+	if !hasYearDigits(str) {
+		return time.Time{}, false
+	}
+	cals := calendars
+	if cal := selectedCalendar(opts); cal != nil {
+		cals = []Calendar{cal}
+	}
+	words := calendarWords(make([]string, 0, fieldBufLen), str)
+	if len(words) < 3 {
+		return time.Time{}, false
+	}
+
+	// A month name has up to three words.
+	for k := min(3, len(words)-2); k >= 1; k-- {
+		var day, name, year string
+		var rest []string
+		switch {
+		case isAllDigits(words[0]) && len(words) >= k+2:
+			day, name, year = words[0], strings.Join(words[1:1+k], " "), words[1+k]
+			rest = words[2+k:]
+		case len(words) >= k+2:
+			name, day, year = strings.Join(words[:k], " "), strings.TrimSuffix(words[k], ","), words[k+1]
+			rest = words[k+2:]
+		}
+		if day == "" || len(day) > 2 || !isAllDigits(day) || year == "" || len(year) > 4 || !isAllDigits(year) {
+			continue
+		}
+		for _, cal := range cals {
+			if month, ok := cal.Month(name); ok {
+				return calendarTime(cal, digitsValue(year), month, digitsValue(day), strings.Join(rest, " "), now, loc, opts)
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseCalendarNumeric parses "yyyy-mm-dd", with "-", "/" or "." as the
+// separator, optionally followed by a time, as a date of the calendar set
+// with InCalendar.
+func parseCalendarNumeric(str string, now time.Time, loc *time.Location, opts []Option) (time.Time, bool) {
+	cal := selectedCalendar(opts)
+	if cal == nil {
+		return time.Time{}, false
+	}
+	date, rest, _ := strings.Cut(str, " ")
+	sep := strings.IndexAny(date, "-/.")
+	if sep < 3 || sep > 4 {
+		return time.Time{}, false
+	}
+	year, month, day := splitNumericDate(date, date[sep])
+	for i, p := range []string{year, month, day} {
+		if p == "" || !isAllDigits(p) || (i > 0 && len(p) > 2) {
+			return time.Time{}, false
+		}
+	}
+	return calendarTime(cal, digitsValue(year), digitsValue(month), digitsValue(day), rest, now, loc, opts)
+}
+
+// calendarTime returns the time of day of month of year in cal, at the
+// time given by rest, or midnight when rest is empty.
+func calendarTime(cal Calendar, year, month, day int, rest string, now time.Time, loc *time.Location, opts []Option) (time.Time, bool) {
+	y, m, d, ok := cal.Gregorian(year, month, day)
+	if !ok {
+		return time.Time{}, false
+	}
+	if rest == "" {
+		return time.Date(y, m, d, 0, 0, 0, 0, loc), true
+	}
+	// The date is now Gregorian and must not be read in the calendar again.
+	gregorian := make([]Option, 0, len(opts)+1)
+	for _, opt := range opts {
+		if _, ok := opt.(calendarOption); !ok {
+			gregorian = append(gregorian, opt)
+		}
+	}
+	t, err := parseCalendarTime(fmt.Sprintf("%04d-%02d-%02d %s", y, m, d, rest), pinReference(gregorian, now), newParsedDate())
+	return t, err == nil
+}
+
+// hasYearDigits reports whether s has a run of at least three digits and a
+// letter, as dates with a month name do.
+func hasYearDigits(s string) bool {
+	run, digits, letters := 0, false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isDigit(c):
+			run++
+			digits = digits || run >= 3
+			continue
+		case c >= 'a' && c <= 'z':
+			letters = true
+		}
+		run = 0
+	}
+	return digits && letters
+}
+
+// calendarWords appends the words of s to dst, dropping apostrophes and
+// splitting words joined by hyphens: "rabi' al-awwal" is "rabi", "al" and
+// "awwal".
+func calendarWords(dst []string, s string) []string {
+	if strings.ContainsAny(s, "'-") {
+		s = stripCalendarPunct(s)
+	}
+	return appendFields(dst, s)
+}
+
+// stripCalendarPunct returns s without apostrophes and with spaces for the
+// hyphens joining words.
+func stripCalendarPunct(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\'':
+			continue
+		case c == '-' && i > 0 && i+1 < len(s) && isLetterByte(s[i-1]) && isLetterByte(s[i+1]):
+			c = ' '
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func parseCalendarDateInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseCalendarDate(str, now, loc, opts)
+	if !ok {
+		return false
+	}
+	setCalendarTime(pd, t)
+	return true
+}
+
+// parseCalendarNumericInto is the first stage of the pipeline when a
+// calendar is set with InCalendar, so that its numeric dates are not taken
+// for Gregorian ones.
+func parseCalendarNumericInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseCalendarNumeric(str, now, loc, opts)
+	if !ok {
+		return false
+	}
+	setCalendarTime(pd, t)
+	return true
+}
+
+// setCalendarTime records t, read from a date of another calendar, in pd.
+func setCalendarTime(pd *ParsedDate, t time.Time) {
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.setMaterialized(t)
+}
@@ -0,0 +1,227 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// PersianCalendar is the Solar Hijri calendar of Iran and Afghanistan
+// (Jalali), whose year starts at the March equinox: 1 Farvardin 1403 is
+// March 20th, 2024. Leap years follow the astronomical rule, as computed by
+// Borkowski's algorithm, for years -61 to 3177. Months are numbered from 1,
+// Farvardin, to 12, Esfand.
+var PersianCalendar Calendar = persianCalendar{}
+
+// HebrewCalendar is the Hebrew calendar: 1 Tishrei 5785 is October 3rd,
+// 2024. Months are numbered from Nisan, as in the Bible, so the year starts
+// with Tishrei, month 7, and Adar is month 12; in leap years "Adar" and
+// "Adar I" are month 12 and "Adar II" month 13.
+var HebrewCalendar Calendar = hebrewCalendar{}
+
+// HijriCalendar is the tabular Islamic calendar, in its most common form
+// (leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30-year
+// cycle, epoch July 16th, 622): 1 Ramadan 1445 is March 11th, 2024. Being
+// arithmetic, it may differ by a day or two from calendars set by the
+// sighting of the moon or by Umm al-Qura. Months are numbered from 1,
+// Muharram, to 12, Dhu al-Hijjah.
+var HijriCalendar Calendar = hijriCalendar{}
+
+// calendarDate returns the Gregorian date of the day rd days after
+// December 31st, 1 BC (rata die, as in Calendrical Calculations).
+func calendarDate(rd int) (int, time.Month, int) {
+	return time.Date(1, time.January, rd, 0, 0, 0, 0, time.UTC).Date()
+}
+
+type persianCalendar struct{}
+
+// persianBreaks are the years around which the 33-year leap cycles of the
+// Persian calendar shift.
+var persianBreaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+func (persianCalendar) Month(name string) (int, bool) {
+	m, ok := persianMonths[name]
+	return m, ok
+}
+
+func (persianCalendar) Gregorian(year, month, day int) (int, time.Month, int, bool) {
+	gy, march, leap, ok := persianYear(year)
+	if !ok || month < 1 || month > 12 || day < 1 {
+		return 0, 0, 0, false
+	}
+	switch {
+	case month <= 6 && day > 31,
+		month > 6 && month < 12 && day > 30,
+		month == 12 && day > 29+leap:
+		return 0, 0, 0, false
+	}
+	days := (month-1)*31 - month/7*(month-7) + day - 1
+	y, m, d := time.Date(gy, time.March, march+days, 0, 0, 0, 0, time.UTC).Date()
+	return y, m, d, true
+}
+
+// persianYear returns the Gregorian year in which year starts, the day of
+// March it starts on, and 1 when year is a leap year, 0 otherwise.
+func persianYear(year int) (gy, march, leap int, ok bool) {
+	if year < persianBreaks[0] || year >= persianBreaks[len(persianBreaks)-1] {
+		return 0, 0, 0, false
+	}
+	gy = year + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	if ((n+1)%33-1)%4 == 0 {
+		leap = 1
+	}
+	return gy, march, leap, true
+}
+
+var persianMonths = map[string]int{
+	"farvardin": 1, "ordibehesht": 2, "khordad": 3, "tir": 4,
+	"mordad": 5, "amordad": 5, "shahrivar": 6, "mehr": 7,
+	"aban": 8, "azar": 9, "dey": 10, "dei": 10,
+	"bahman": 11, "esfand": 12,
+}
+
+type hebrewCalendar struct{}
+
+// hebrewEpoch is the rata die of 1 Tishrei AM 1.
+const hebrewEpoch = -1373427
+
+func (hebrewCalendar) Month(name string) (int, bool) {
+	m, ok := hebrewMonths[name]
+	return m, ok
+}
+
+func (hebrewCalendar) Gregorian(year, month, day int) (int, time.Month, int, bool) {
+	if year < 1 || month < 1 || month > hebrewLastMonth(year) || day < 1 || day > hebrewMonthDays(year, month) {
+		return 0, 0, 0, false
+	}
+	rd := hebrewNewYear(year) + day - 1
+	if month < 7 {
+		for m := 7; m <= hebrewLastMonth(year); m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+		for m := 1; m < month; m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			rd += hebrewMonthDays(year, m)
+		}
+	}
+	y, m, d := calendarDate(rd)
+	return y, m, d, true
+}
+
+// hebrewLeap reports whether year has 13 months.
+func hebrewLeap(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewLastMonth returns the number of the last month of year, Adar or
+// Adar II.
+func hebrewLastMonth(year int) int {
+	if hebrewLeap(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewElapsedDays returns the number of days from the epoch to the
+// molad of Tishrei of year, moved by the first postponement rule.
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	day := 29*months + parts/25920
+	if 3*(day+1)%7 < 3 {
+		day++
+	}
+	return day
+}
+
+// hebrewNewYear returns the rata die of 1 Tishrei of year.
+func hebrewNewYear(year int) int {
+	prev, cur, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	switch {
+	case next-cur == 356:
+		correction = 2
+	case cur-prev == 382:
+		correction = 1
+	}
+	return hebrewEpoch + cur + correction
+}
+
+// hebrewMonthDays returns the number of days of month in year.
+func hebrewMonthDays(year, month int) int {
+	length := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13,
+		month == 12 && !hebrewLeap(year),
+		month == 8 && length%10 != 5,
+		month == 9 && length%10 == 3:
+		return 29
+	}
+	return 30
+}
+
+var hebrewMonths = map[string]int{
+	"nisan": 1, "nissan": 1, "iyar": 2, "iyyar": 2, "sivan": 3,
+	"tammuz": 4, "tamuz": 4, "av": 5, "elul": 6,
+	"tishrei": 7, "tishri": 7, "cheshvan": 8, "heshvan": 8,
+	"marcheshvan": 8, "marheshvan": 8, "kislev": 9, "tevet": 10,
+	"teves": 10, "shevat": 11, "shvat": 11, "adar": 12,
+	"adar i": 12, "adar alef": 12, "adar ii": 13, "adar bet": 13,
+	"veadar": 13,
+}
+
+type hijriCalendar struct{}
+
+// hijriEpoch is the rata die of the day before 1 Muharram 1.
+const hijriEpoch = 227014
+
+func (hijriCalendar) Month(name string) (int, bool) {
+	m, ok := hijriMonths[name]
+	return m, ok
+}
+
+func (hijriCalendar) Gregorian(year, month, day int) (int, time.Month, int, bool) {
+	length := 30 - (month+1)%2
+	if month == 12 && (14+11*year)%30 < 11 {
+		length = 30
+	}
+	if year < 1 || month < 1 || month > 12 || day < 1 || day > length {
+		return 0, 0, 0, false
+	}
+	rd := hijriEpoch + (year-1)*354 + (3+11*year)/30 + 29*(month-1) + month/2 + day
+	y, m, d := calendarDate(rd)
+	return y, m, d, true
+}
+
+var hijriMonths = map[string]int{
+	"muharram": 1, "safar": 2,
+	"rabi al awwal": 3, "rabi ul awwal": 3, "rabi al awal": 3, "rabiul awal": 3, "rabi i": 3,
+	"rabi al thani": 4, "rabi al akhir": 4, "rabi ul akhir": 4, "rabi ul thani": 4, "rabi ii": 4,
+	"jumada al ula": 5, "jumada al awwal": 5, "jumada al oola": 5, "jumada ul awwal": 5, "jumada i": 5,
+	"jumada al thani": 6, "jumada al akhirah": 6, "jumada al akhira": 6, "jumada ul akhir": 6, "jumada ii": 6,
+	"rajab": 7, "shaban": 8, "ramadan": 9, "ramazan": 9, "shawwal": 10,
+	"dhu al qidah": 11, "dhu al qadah": 11, "dhul qidah": 11, "dhul qadah": 11, "zul qadah": 11,
+	"dhu al hijjah": 12, "dhul hijjah": 12, "zul hijjah": 12,
+}
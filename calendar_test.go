@@ -0,0 +1,82 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarDates(t *testing.T) {
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	tests := []struct {
+		input string
+		opts  []Option
+		want  string
+	}{
+		{"15 Farvardin 1403", nil, "2024-04-03 00:00:00"},
+		{"1 Farvardin 1403", nil, "2024-03-20 00:00:00"},
+		{"Esfand 30, 1403", nil, "2025-03-20 00:00:00"},
+		{"10 Tishrei 5785", nil, "2024-10-12 00:00:00"},
+		{"15 Adar II 5784 10:30", nil, "2024-03-25 10:30:00"},
+		{"14 Adar 5784", nil, "2024-02-23 00:00:00"},
+		{"1 Nisan 5784", nil, "2024-04-09 00:00:00"},
+		{"1 Ramadan 1445", nil, "2024-03-11 00:00:00"},
+		{"1 Rabi' al-Awwal 1446", nil, "2024-09-05 00:00:00"},
+		{"1403-01-15", []Option{InCalendar(PersianCalendar)}, "2024-04-03 00:00:00"},
+		{"1403/1/15 14:00", []Option{InCalendar(PersianCalendar)}, "2024-04-03 14:00:00"},
+		{"1446-09-01", []Option{InCalendar(HijriCalendar)}, "2025-03-01 00:00:00"},
+		{"15 January 2024", []Option{InCalendar(HijriCalendar)}, "2024-01-15 00:00:00"},
+	}
+	for _, tt := range tests {
+		got, err := StrToTime(tt.input, append([]Option{base, InTZ(time.UTC)}, tt.opts...)...)
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", tt.input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != tt.want {
+			t.Errorf("StrToTime(%q) = %s, want %s", tt.input, s, tt.want)
+		}
+	}
+
+	for _, input := range []string{"30 Esfand 1402", "30 Kislev 5784", "15 Adar II 5785", "1 Farvardin 3178"} {
+		if got, err := StrToTime(input, base); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+	if _, err := StrToTime("10 Tishrei 5785", base, InCalendar(PersianCalendar)); err == nil {
+		t.Error("InCalendar(PersianCalendar) accepted a Hebrew month")
+	}
+}
+
+func TestPersianLeapYears(t *testing.T) {
+	// Leap years of the 1390s and 1400s according to the Iranian calendar.
+	leap := map[int]bool{1391: true, 1395: true, 1399: true, 1403: true, 1408: true}
+	for year := 1390; year < 1410; year++ {
+		_, _, l, _ := persianYear(year)
+		if (l == 1) != leap[year] {
+			t.Errorf("persianYear(%d) leap = %d, want %v", year, l, leap[year])
+		}
+	}
+}
+
+type marsCalendar struct{}
+
+func (marsCalendar) Month(name string) (int, bool) {
+	return 1, name == "sol"
+}
+
+func (marsCalendar) Gregorian(year, month, day int) (int, time.Month, int, bool) {
+	return 2000 + year, time.January, day, true
+}
+
+func TestRegisterCalendar(t *testing.T) {
+	saved := calendars
+	defer func() { calendars = saved }()
+	RegisterCalendar(marsCalendar{})
+
+	got, err := StrToTime("5 sol 124", InTZ(time.UTC))
+	if err != nil || !got.Equal(time.Date(2124, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StrToTime(\"5 sol 124\") = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,137 @@
+// Command strtotime parses date and time expressions the way PHP's strtotime
+// does and prints the result.
+//
+// Usage:
+//
+//	strtotime [flags] [expression ...]
+//
+// Each argument is parsed as one expression. Without arguments, expressions
+// are read from the standard input, one per line. Results are printed one
+// per line, as Unix timestamps by default:
+//
+//	$ strtotime -tz UTC -rel "2024-06-14 09:30" "next monday" "+1 week 2 days"
+//	1718582400
+//	1719135000
+//	$ echo "last day of next month" | strtotime -tz Europe/Paris -rel "2024-06-14 09:30" -format "Y-m-d H:i:s T"
+//	2024-07-31 09:30:00 CEST
+//
+// The flags are:
+//
+//	-format string
+//		output format: "unix" (the default), "rfc3339", or a PHP date()
+//		format such as "Y-m-d H:i:s"
+//	-tz string
+//		timezone used when an expression doesn't name one (default: local)
+//	-rel string
+//		base time for relative expressions, itself an expression such as
+//		"2024-01-01 12:00" or "@1700000000" (default: now)
+//	-locale string
+//		language of the expressions; only "en" is built in
+//
+// Expressions that fail to parse are reported on the standard error, and the
+// command then exits with status 1.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/KarpelesLab/strtotime"
+)
+
+func main() {
+	format := flag.String("format", "unix", `output format: "unix", "rfc3339", or a PHP date() format such as "Y-m-d H:i:s"`)
+	tz := flag.String("tz", "", "timezone used when an expression doesn't name one (default: local)")
+	rel := flag.String("rel", "", "base time for relative expressions, itself an expression (default: now)")
+	locale := flag.String("locale", "en", `language of the expressions; only "en" is built in`)
+	flag.Parse()
+
+	opts, err := options(*tz, *rel, *locale)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "strtotime: %v\n", err)
+		os.Exit(2)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	failed := false
+	parse := func(expr string) {
+		t, err := strtotime.StrToTime(expr, opts...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "strtotime: %v\n", err)
+			failed = true
+			return
+		}
+		fmt.Fprintln(w, formatTime(t, *format))
+	}
+
+	if flag.NArg() > 0 {
+		for _, expr := range flag.Args() {
+			parse(expr)
+		}
+	} else if err := eachLine(os.Stdin, parse); err != nil {
+		fmt.Fprintf(os.Stderr, "strtotime: %v\n", err)
+		failed = true
+	}
+
+	if failed {
+		w.Flush()
+		os.Exit(1)
+	}
+}
+
+// options returns the parse options selected by the -tz, -rel and -locale
+// flags.
+func options(tz, rel, locale string) ([]strtotime.Option, error) {
+	if locale != "en" {
+		return nil, fmt.Errorf("unsupported locale %q", locale)
+	}
+
+	var opts []strtotime.Option
+	if tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, strtotime.InTZ(loc))
+	}
+	if rel != "" {
+		base, err := strtotime.StrToTime(rel, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("-rel: %w", err)
+		}
+		opts = append(opts, strtotime.Rel(base))
+	}
+	return opts, nil
+}
+
+// formatTime formats t as selected by the -format flag.
+func formatTime(t time.Time, format string) string {
+	switch format {
+	case "unix":
+		return strconv.FormatInt(t.Unix(), 10)
+	case "rfc3339":
+		return t.Format(time.RFC3339Nano)
+	}
+	return strtotime.FormatPHP(format, t)
+}
+
+// eachLine calls fn with each non-empty line read from r.
+func eachLine(r io.Reader, fn func(string)) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if line := sc.Text(); line != "" {
+			fn(line)
+		}
+	}
+	if err := sc.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package strtotime
 
 import (
+	"errors"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -11,77 +13,169 @@ import (
 // true if the input matched its format.
 type componentParser func(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool
 
+// firstByte classifies the leading byte of a normalized input. Each
+// formatParsers entry declares the classes it can match so that relative
+// phrases never reach the numeric parsers and vice versa.
+type firstByte uint8
+
+const (
+	firstDigit firstByte = 1 << iota
+	firstAlpha
+	firstSign
+	firstOther
+
+	firstAny = firstDigit | firstAlpha | firstSign | firstOther
+)
+
+// classifyFirstByte returns the firstByte class of str[0].
+func classifyFirstByte(str string) firstByte {
+	if len(str) == 0 {
+		return firstOther
+	}
+	switch c := str[0]; {
+	case c >= '0' && c <= '9':
+		return firstDigit
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return firstAlpha
+	case c == '+' || c == '-':
+		return firstSign
+	}
+	return firstOther
+}
+
+// formatParser is one entry of formatParsers.
+type formatParser struct {
+	first firstByte // leading byte classes this parser can match
+	name  string    // format name reported to OnParse hooks
+	parse componentParser
+}
+
 // formatParsers is the ordered pipeline shared by StrToTime and DateParse.
 // Each entry is a wrapper around one of the parse* functions in
 // date_formats.go / extended_formats.go / iso8601.go / date_with_timezone.go,
 // with explicit knowledge of which ParsedDate fields that parser populates.
-var formatParsers = []componentParser{
-	guardDigit(wrapDateOnly(parseEuropeanFormat)),
-	guardPrefix("front of ", "back of ")(parseFrontBackOfInto),
-	guardDigit(wrapDateOnly(parseRomanNumeralDate)),
-	guardPrefix("0000-00-00")(parseZeroDateInto),
-	guardByte('-', '+')(parseSignedYearInto),
-	guardByte('-', '+')(parseBareNumericOffsetInto),
-	parseISO8601Into,
-	parseDateTimeFormatInto,
-	parseTimeWithNumericOffsetInto,
-	parseTimeWithNamedTZInto,
-	parseWithTimezoneInto,
-	wrapDateOnly(parseISOFormat),
-	guardDigit(parseInvalidISOFormatInto),
-	guardDigit(parseInvalidDottedDateInto),
-	parseInvalidMonthNameDateInto,
-	guardDigit(parseLargeYearAsTimeInto),
-	parseYearMonthFormatInto,
-	guardDigit(wrapDateOnly(parseSlashFormat)),
-	guardDigit(wrapDateOnly(parseUSFormat)),
-	guardDigit(parseUSDateWithTimeInto),
-	guardDigit(parseShortYearUSDateWithMilitaryTimeInto),
-	guardDigit(parseCompactDateWithTimeInto),
-	guardDigit(parseCompactTimestampInto),
-	parseCompactTimeFormatsInto,
-	parseMonthNameFormatInto,
-	guardDigit(parseHTTPLogFormatInto),
-	parseDateTimeTZRelativeInto,
-	parseDateWithTZInto,
-	parseDayMonthYearInto,
-	parseMonthYearOnlyInto,
-	guardDigit(parseTimeBeforeDateInto),
-	parseMonthDayTimeYearInto,
-	parseFirstLastDayOfDateInto,
-	parseNumberedWeekdayInto,
-	guardDigit(parseOrdinalOfMonthYearInto),
-	parseBareTimezoneInto,
-	guardDigit(parseBareDigitsFallbackInto),
+//
+// The PHP grammar comes first, then the extra formats that minimal builds
+// leave out (see extended.go), then the catch-all fallbacks.
+var formatParsers = slices.Concat(coreFormatParsers, extendedFormatParsers, fallbackFormatParsers)
+
+// coreFormatParsers are the formats of PHP's strtotime grammar.
+var coreFormatParsers = []formatParser{
+	{firstDigit, "european_date", wrapDateOnly(parseEuropeanFormat)},
+	{firstAlpha, "front_back_of", guardPrefix("front of ", "back of ")(parseFrontBackOfInto)},
+	{firstDigit, "roman_numeral_date", parseRomanNumeralDateInto},
+	{firstDigit, "zero_date", guardPrefix("0000-00-00")(parseZeroDateInto)},
+	{firstSign, "signed_year", parseSignedYearInto},
+	{firstSign, "numeric_offset", parseBareNumericOffsetInto},
+	{firstAny, "iso8601", parseISO8601Into},
+	{firstAny, "date_time", parseDateTimeFormatInto},
+	{firstDigit, "time_with_offset", parseTimeWithNumericOffsetInto},
+	{firstDigit, "time_with_zone", guardZone(parseTimeWithNamedTZInto)},
+	{firstAny, "with_zone", guardZone(parseWithTimezoneInto)},
+	{firstDigit, "iso_date", wrapDateOnly(parseISOFormat)},
+	{firstDigit, "invalid_iso_date", parseInvalidISOFormatInto},
+	{firstDigit, "invalid_dotted_date", parseInvalidDottedDateInto},
+	{firstAlpha, "invalid_month_name_date", parseInvalidMonthNameDateInto},
+	{firstDigit, "large_year_as_time", parseLargeYearAsTimeInto},
+	{firstDigit, "year_month", parseYearMonthFormatInto},
+	{firstDigit, "slash_date", wrapDateOnly(parseSlashFormat)},
+	{firstDigit, "us_date", wrapDateOnly(parseUSFormat)},
+	{firstDigit, "us_short_date", parseUSShortFormatInto},
+	{firstDigit, "us_date_time", parseUSDateWithTimeInto},
+	{firstDigit, "us_date_military_time", parseShortYearUSDateWithMilitaryTimeInto},
+	{firstDigit, "compact_date_time", parseCompactDateWithTimeInto},
+	{firstDigit, "compact_timestamp", parseCompactTimestampInto},
+	{firstAny, "compact_time", parseCompactTimeFormatsInto},
+	{firstAny, "month_name_date", parseMonthNameFormatInto},
+	{firstDigit, "http_log", parseHTTPLogFormatInto},
+	{firstDigit, "exif", parseEXIFTimestampInto},
+	{firstAny, "date_time_zone_relative", parseDateTimeTZRelativeInto},
+	{firstDigit, "date_with_zone", guardZone(parseDateWithTZInto)},
+	{firstAny, "day_month_year", parseDayMonthYearInto},
+	{firstAny, "month_year", parseMonthYearOnlyInto},
+	{firstDigit, "time_before_date", parseTimeBeforeDateInto},
+	{firstAlpha, "month_day_time_year", parseMonthDayTimeYearInto},
+	{firstAlpha, "first_last_day_of", guardPrefix("first day of ", "last day of ")(parseFirstLastDayOfDateInto)},
+	{firstAny, "numbered_weekday", parseNumberedWeekdayInto},
+	{firstDigit, "ordinal_of_month", parseOrdinalOfMonthYearInto},
+}
+
+// fallbackFormatParsers run last, once every specific format has been tried.
+var fallbackFormatParsers = []formatParser{
+	{firstAlpha, "bare_zone", guardZone(parseBareTimezoneInto)},
+	{firstDigit, "bare_digits", parseBareDigitsFallbackInto},
+}
+
+// formatParsersByClass lists, for each firstByte class, the indexes of the
+// formatParsers entries that may match an input starting with that class.
+var formatParsersByClass = func() (byClass [firstOther + 1][]int) {
+	for _, class := range []firstByte{firstDigit, firstAlpha, firstSign, firstOther} {
+		for i, fp := range formatParsers {
+			if fp.first&class != 0 {
+				byClass[class] = append(byClass[class], i)
+			}
+		}
+	}
+	return
+}()
+
+// runFormatParsers tries the formatParsers entries that accept the leading
+// byte of str, in order, and copies the first successful result into pd.
+// The order alone decides which entry reads an input: inputs of the same
+// layout, such as "15.01.24" and "10.43.31", may need different ones. The
+// entries read the settings from cfg, given to them as the cfg of the
+// ParsedDate they fill.
+func runFormatParsers(str string, now time.Time, loc *time.Location, opts []Option, cfg parseSettings, pd *ParsedDate) bool {
+	for _, i := range formatParsersByClass[classifyFirstByte(str)] {
+		sub := pd.subParse()
+		sub.cfg = cfg
+		if formatParsers[i].parse(str, now, loc, opts, sub) && acceptSubParse(cfg, pd, sub) {
+			mergeSubParse(pd, sub)
+			pd.format = formatParsers[i].name
+			return true
+		}
+	}
+	return false
 }
 
-// --- guards (componentParser flavor) ---
-
-func guardDigit(fn componentParser) componentParser {
-	return func(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-		if len(str) == 0 || str[0] < '0' || str[0] > '9' {
-			return false
-		}
-		return fn(str, now, loc, opts, pd)
+// acceptSubParse reports whether a successful formatParsers result is
+// allowed under cfg. With DisableTZDetection, a parser that read a zone name
+// is treated as not matching so that the next one gets a chance, and so is
+// one that left text unread unless the Lenient option is set. That text is
+// noted in pd for the error should no later stage match.
+func acceptSubParse(cfg parseSettings, pd, sub *ParsedDate) bool {
+	if cfg.noTZDetection && sub.hasNamedZone() {
+		return false
+	}
+	if sub.leftover != "" && !cfg.lenient {
+		pd.noteUnread(sub.leftover)
+		return false
 	}
+	return true
 }
 
-func guardByte(bytes ...byte) func(componentParser) componentParser {
-	return func(fn componentParser) componentParser {
-		return func(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-			if len(str) == 0 {
-				return false
-			}
-			for _, b := range bytes {
-				if str[0] == b {
-					return fn(str, now, loc, opts, pd)
-				}
-			}
-			return false
-		}
+// mergeSubParse copies the outcome of a successful formatParsers entry into pd.
+func mergeSubParse(pd, sub *ParsedDate) {
+	copyComponents(pd, sub)
+	if sub.hasMaterialized {
+		pd.setMaterialized(sub.materialized)
+	}
+	if sub.Relative != nil {
+		// Copied rather than shared: sub is reset for the next parse.
+		*pd.relative() = *sub.Relative
+	}
+	if sub.relativeApplied {
+		pd.relativeApplied = true
+	}
+	if sub.cause != nil {
+		pd.cause = sub.cause
 	}
+	pd.leftover = sub.leftover
+	pd.dayDefaulted = sub.dayDefaulted
 }
 
+// --- guards (componentParser flavor) ---
+
 func guardPrefix(prefixes ...string) func(componentParser) componentParser {
 	return func(fn componentParser) componentParser {
 		return func(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
@@ -95,6 +189,17 @@ func guardPrefix(prefixes ...string) func(componentParser) componentParser {
 	}
 }
 
+// guardZone marks a parser whose format always includes a timezone name, so
+// it is skipped entirely under DisableTZDetection.
+func guardZone(fn componentParser) componentParser {
+	return func(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+		if pd.cfg.noTZDetection {
+			return false
+		}
+		return fn(str, now, loc, opts, pd)
+	}
+}
+
 // wrapDateOnly wraps a legacy date-only parser (yields only y/m/d with time = 00:00:00)
 // as a componentParser that records SetDate only.
 func wrapDateOnly(fn func(string, *time.Location) (time.Time, bool)) componentParser {
@@ -111,9 +216,9 @@ func wrapDateOnly(fn func(string, *time.Location) (time.Time, bool)) componentPa
 
 // parseInvalidMonthNameDateInto matches "<MonthName> DD YYYY" where DD is
 // out of range for that month. PHP still reports the components plus a
-// warning.
+// warning, which DateParse mirrors.
 func parseInvalidMonthNameDateInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 3 {
 		return false
 	}
@@ -135,6 +240,8 @@ func parseInvalidMonthNameDateInto(str string, now time.Time, loc *time.Location
 	pd.SetDate(year, int(m), day)
 	pd.AddWarning(len(str)+1, "The parsed date was invalid")
 	pd.setMaterialized(time.Date(year, m, day, 0, 0, 0, 0, loc))
+	// StrToTime rejects the date unless Rollover is set.
+	pd.cause = NewInvalidDateError(year, int(m), day)
 	return true
 }
 
@@ -253,16 +360,19 @@ func parseInvalidDottedDateInto(str string, now time.Time, loc *time.Location, o
 }
 
 // parseTimeWithNamedTZInto matches "HH:MM[:SS][.frac] <TZname>" where
-// <TZname> is an abbreviation, IANA identifier, or "Z". Emits time plus TZ
-// metadata, no date.
+// <TZname> is an abbreviation, IANA identifier, or "Z", and the 12-hour
+// "HH:MM[:SS] am|pm <TZname>". Emits time plus TZ metadata, no date.
 func parseTimeWithNamedTZInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	fields := strings.Fields(str)
-	if len(fields) != 2 {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if len(fields) != 2 && len(fields) != 3 {
 		return false
 	}
-	timePart := fields[0]
-	tzStr := fields[1]
-	if !strings.Contains(timePart, ":") {
+	timePart, tzStr := fields[0], fields[len(fields)-1]
+	if len(fields) == 3 {
+		timePart = fields[0] + " " + fields[1]
+	}
+	timePart, ampm := cutMeridian(timePart)
+	if !strings.Contains(timePart, ":") || strings.Contains(timePart, " ") {
 		return false
 	}
 	if len(tzStr) == 0 || tzStr[0] == '+' || tzStr[0] == '-' {
@@ -273,7 +383,7 @@ func parseTimeWithNamedTZInto(str string, now time.Time, loc *time.Location, opt
 	}
 
 	h, m, s, consumed, ok := parseFlexTime(timePart)
-	if !ok {
+	if !ok || !applyMeridian(&h, ampm, consumed == len(timePart)) {
 		return false
 	}
 	hasFrac := false
@@ -290,12 +400,15 @@ func parseTimeWithNamedTZInto(str string, now time.Time, loc *time.Location, opt
 	if hasFrac {
 		pd.SetFraction(frac)
 	}
+	// The time is read in the zone given, on the current day.
+	zone := time.UTC
 	if strings.EqualFold(tzStr, "Z") {
 		pd.SetTZAbbreviation(time.UTC, "Z", 0, false)
 	} else if resolved, found := tryParseTimezone(tzStr); found {
 		setTZFromName(pd, tzStr, resolved)
+		zone = resolved
 	}
-	pd.setMaterialized(time.Date(now.Year(), now.Month(), now.Day(), h, m, s, int(frac*1e9), loc))
+	pd.setMaterialized(time.Date(now.Year(), now.Month(), now.Day(), h, m, s, int(frac*1e9), zone))
 	return true
 }
 
@@ -303,77 +416,91 @@ func parseTimeWithNamedTZInto(str string, now time.Time, loc *time.Location, opt
 // "-1 week +2 days" / "+1 year -2 months" / "-3 hours +10 minutes". The
 // result is reported as a relative-only ParsedDate with no absolute date.
 func parseCompoundRelativeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	normalizer := strings.NewReplacer(" + ", " +", " - ", " -", "+ ", "+", "- ", "-")
-	s := strings.TrimSpace(normalizer.Replace(str))
+	var buf [8]int
+	offsets := compoundOperators(str, buf[:0])
 
+	// Split before each operator, keeping the sign with the term it starts.
 	var parts []string
-	current := ""
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if (c == '+' || c == '-') && i > 0 && current != "" {
-			parts = append(parts, strings.TrimSpace(current))
-			current = string(c)
-		} else {
-			current += string(c)
-		}
-	}
-	if current != "" {
-		parts = append(parts, strings.TrimSpace(current))
+	start := 0
+	for _, off := range offsets {
+		parts = append(parts, str[start:off])
+		start = off
 	}
+	parts = append(parts, str[start:])
 	if len(parts) < 2 {
 		return false
 	}
 
 	// Each part must be a pure relative expression: "[+-]N unit".
-	type relPart struct {
-		amount int
-		unit   string
-	}
-	var rels []relPart
+	rel := &Relative{}
 	for _, p := range parts {
-		sign := 1
-		body := p
-		if len(body) == 0 {
+		if !addRelativeTerm(rel, p) {
 			return false
 		}
-		switch body[0] {
-		case '+':
-			body = body[1:]
-		case '-':
-			sign = -1
-			body = body[1:]
-		}
-		body = strings.TrimSpace(body)
-		fields := strings.Fields(body)
-		if len(fields) != 2 {
-			return false
-		}
-		amount, err := strconv.Atoi(fields[0])
-		if err != nil {
-			return false
-		}
-		unit := normalizeTimeUnit(fields[1])
-		switch unit {
-		case UnitYear, UnitMonth, UnitWeek, UnitDay, UnitHour, UnitMinute, UnitSecond:
-		default:
-			return false
-		}
-		rels = append(rels, relPart{sign * amount, unit})
 	}
 
-	for _, r := range rels {
-		pd.AddRelative(r.unit, r.amount)
-	}
 	// Materialize for StrToTime so it still returns a meaningful time.Time.
-	t := now
-	for _, r := range rels {
-		t = applyTimeOffset(t, r.amount, r.unit)
+	// The accumulated block is applied in one go, like PHP does, so that
+	// "-1 day +1 month" and "+1 month -1 day" agree.
+	t, err := applyRelative(now, rel, loc, pd.cfg)
+	if err != nil {
+		pd.cause = err
+		return false
 	}
+	pd.Relative = rel
 	pd.setMaterialized(t)
 	pd.relativeApplied = true
 	return true
 }
 
+// addRelativeTerm parses a single "[+-]N unit" term and accumulates it into
+// rel. It reports false when term is anything else, including weekday
+// offsets, whose result depends on where they are applied.
+func addRelativeTerm(rel *Relative, term string) bool {
+	sign := 1
+	body := strings.TrimSpace(term)
+	if len(body) == 0 {
+		return false
+	}
+	switch body[0] {
+	case '+':
+		body = body[1:]
+	case '-':
+		sign = -1
+		body = body[1:]
+	}
+	fields := appendFields(make([]string, 0, fieldBufLen), body)
+	if len(fields) != 2 {
+		return false
+	}
+	amount, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return false
+	}
+	amount *= sign
+	switch normalizeTimeUnit(fields[1]) {
+	case UnitYear:
+		rel.Year += amount
+	case UnitMonth:
+		rel.Month += amount
+	case UnitWeek:
+		rel.Day += amount * 7
+	case UnitFortnight:
+		rel.Day += amount * 14
+	case UnitDay:
+		rel.Day += amount
+	case UnitHour:
+		rel.Hour += amount
+	case UnitMinute:
+		rel.Minute += amount
+	case UnitSecond:
+		rel.Second += amount
+	default:
+		return false
+	}
+	return true
+}
+
 // parseBareTimezoneInto matches a standalone timezone string like "UTC",
 // "EST", "Z", "Asia/Tokyo". PHP reports is_localtime=true plus the
 // appropriate zone_type, and no date or time components. Unknown alphabetic
@@ -431,7 +558,7 @@ func parseBareTimezoneInto(str string, now time.Time, loc *time.Location, opts [
 // specification" warning at the position of the space before "of", and
 // produces sequential-array errors for the unexpected tail.
 func parseOrdinalOfMonthYearInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 4 {
 		return false
 	}
@@ -513,11 +640,12 @@ func parseBareDigitsFallbackInto(str string, now time.Time, loc *time.Location,
 	return true
 }
 
-// parseCompactDateWithTimeInto matches "YYYYMMDD HH:MM[:SS]" — the 8-digit
-// compact date followed by a colon-style time.
+// parseCompactDateWithTimeInto matches "YYYYMMDD HH:MM[:SS[.frac]]" — the
+// 8-digit compact date followed by a colon-style time — optionally followed
+// by a zone name or UTC offset: "20230115 10:30:45.5 +0200".
 func parseCompactDateWithTimeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	fields := strings.Fields(str)
-	if len(fields) != 2 {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if len(fields) != 2 && len(fields) != 3 {
 		return false
 	}
 	if len(fields[0]) != 8 || !isAllDigits(fields[0]) {
@@ -526,19 +654,32 @@ func parseCompactDateWithTimeInto(str string, now time.Time, loc *time.Location,
 	if !strings.Contains(fields[1], ":") {
 		return false
 	}
-	year, _ := strconv.Atoi(fields[0][:4])
-	month, _ := strconv.Atoi(fields[0][4:6])
-	day, _ := strconv.Atoi(fields[0][6:8])
+	year := digitsValue(fields[0][:4])
+	month := digitsValue(fields[0][4:6])
+	day := digitsValue(fields[0][6:8])
 	if !IsValidDate(year, month, day) {
 		return false
 	}
-	h, m, s, _, ok := parseFlexTime(fields[1])
-	if !ok {
+	h, m, s, nanos, consumed, ok := parseISO8601Time(fields[1])
+	if !ok || consumed != len(fields[1]) || !IsValidTime(h, m, s) {
 		return false
 	}
+	zone := loc
+	if len(fields) == 3 {
+		if zone, ok = parseZoneField(fields[2]); !ok {
+			return false
+		}
+	}
 	pd.SetDate(year, month, day)
 	pd.SetTime(h, m, s)
-	pd.setMaterialized(time.Date(year, time.Month(month), day, h, m, s, 0, loc))
+	if nanos != 0 {
+		pd.SetFraction(float64(nanos) / 1e9)
+	}
+	t := time.Date(year, time.Month(month), day, h, m, s, nanos, zone)
+	if len(fields) == 3 {
+		setTZFromName(pd, fields[2], zone)
+	}
+	pd.setMaterialized(t)
 	return true
 }
 
@@ -606,8 +747,9 @@ func parseTimeWithNumericOffsetInto(str string, now time.Time, loc *time.Locatio
 	if !ok {
 		return false
 	}
+	timePart, ampm := cutMeridian(timePart)
 	h, m, s, consumed, ok := parseFlexTime(timePart)
-	if !ok {
+	if !ok || !applyMeridian(&h, ampm, consumed == len(timePart)) {
 		return false
 	}
 	hasFrac := false
@@ -624,8 +766,11 @@ func parseTimeWithNumericOffsetInto(str string, now time.Time, loc *time.Locatio
 	if hasFrac {
 		pd.SetFraction(frac)
 	}
-	pd.SetTZOffset(loc, computeOffsetSeconds(tzPart))
-	pd.setMaterialized(time.Date(now.Year(), now.Month(), now.Day(), h, m, s, int(frac*1e9), loc))
+	// The time is read at the offset given, on the current day.
+	offset := computeOffsetSeconds(tzPart)
+	zone := fixedZone(offset)
+	pd.SetTZOffset(zone, offset)
+	pd.setMaterialized(time.Date(now.Year(), now.Month(), now.Day(), h, m, s, int(frac*1e9), zone))
 	return true
 }
 
@@ -713,11 +858,23 @@ func parseFrontBackOfInto(str string, now time.Time, loc *time.Location, opts []
 	return true
 }
 
+func parseRomanNumeralDateInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, rest, ok := parseRomanNumeralDate(str, loc)
+	if !ok {
+		return false
+	}
+	pd.leftover = rest
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.setMaterialized(t)
+	return true
+}
+
 func parseSignedYearInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	t, ok := parseSignedYear(str, loc)
+	t, rest, ok := parseSignedYear(str, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	pd.SetDate(t.Year(), int(t.Month()), t.Day())
 	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 {
 		pd.SetTime(t.Hour(), t.Minute(), t.Second())
@@ -730,6 +887,11 @@ func parseSignedYearInto(str string, now time.Time, loc *time.Location, opts []O
 }
 
 func parseISO8601Into(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	// Week date followed by a time: "2008-W27-1 10:30", "2008-W27-1T10:30"
+	if date, hour, minute, second, nanos, ok := cutISODateTime(str); ok && strings.Contains(date, "w") && parseISO8601Into(date, now, loc, opts, pd) {
+		setDateTimeOf(pd, hour, minute, second, nanos)
+		return true
+	}
 	// Week date: YYYY-Www[-D] — PHP represents this as year-Jan-1 plus a
 	// relative day offset derived from the ISO week calendar.
 	if t, ok := parseISOWeekDate(str, loc); ok {
@@ -964,7 +1126,7 @@ func parseWithTimezoneInto(str string, now time.Time, loc *time.Location, opts [
 	// the TZ, not the date (PHP reports year/month/day as false).
 	if isTimeOnlyWithTimezoneInput(str) {
 		pd.SetTime(t.Hour(), t.Minute(), t.Second())
-		fields := strings.Fields(str)
+		fields := appendFields(make([]string, 0, fieldBufLen), str)
 		if len(fields) > 0 {
 			tzStr := fields[len(fields)-1]
 			if resolved, found := tryParseTimezone(tzStr); found {
@@ -998,7 +1160,7 @@ func parseWithTimezoneInto(str string, now time.Time, loc *time.Location, opts [
 
 // isTimeOnlyWithTimezoneInput reports whether str looks like "HH:MM[:SS][.frac] <tz>".
 func isTimeOnlyWithTimezoneInput(str string) bool {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 2 {
 		return false
 	}
@@ -1024,13 +1186,24 @@ func parseYearMonthFormatInto(str string, now time.Time, loc *time.Location, opt
 	if len(str) == 0 || str[0] < '0' || str[0] > '9' {
 		return false
 	}
+	// Ordinal date followed by a time: "2008-193 10:30", "2008-193T10:30"
+	if date, hour, minute, second, nanos, ok := cutISODateTime(str); ok && !strings.Contains(date, "w") {
+		if _, doy, found := strings.Cut(date, "-"); found && len(doy) == 3 && parseYearMonthFormatInto(date, now, loc, opts, pd) {
+			setDateTimeOf(pd, hour, minute, second, nanos)
+			return true
+		}
+	}
 	// Detect ISO ordinal date form "YYYY-DDD" so we can report PHP's shape:
 	// year/month=1/day=DoY (not the resolved month/day) plus a warning when
-	// DoY exceeds 365 / 366.
-	parts := strings.SplitN(str, "-", 2)
-	if len(parts) == 2 && len(parts[1]) == 3 && isAllDigits(parts[0]) && isAllDigits(parts[1]) {
-		year, _ := strconv.Atoi(parts[0])
-		doy, _ := strconv.Atoi(parts[1])
+	// DoY exceeds 365 / 366. Like PHP, days past 366 are not ordinal dates
+	// and day 366 of a common year is January 1st of the next one.
+	yearStr, doyStr, found := strings.Cut(str, "-")
+	if found && len(doyStr) == 3 && isAllDigits(yearStr) && isAllDigits(doyStr) {
+		year, _ := strconv.Atoi(yearStr)
+		doy, _ := strconv.Atoi(doyStr)
+		if doy > 366 {
+			return false
+		}
 		if doy >= 1 {
 			pd.SetDate(year, 1, doy)
 			// PHP treats the 3-digit form as (year, month=1, day=DDD) and
@@ -1048,17 +1221,33 @@ func parseYearMonthFormatInto(str string, now time.Time, loc *time.Location, opt
 		return false
 	}
 	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.dayDefaulted = true
 	pd.setMaterialized(t)
 	return true
 }
 
 func parseUSDateWithTimeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	t, ok := parseUSDateWithTime(str, loc)
+	t, rest, ok := parseUSDateWithTime(str, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	pd.SetDate(t.Year(), int(t.Month()), t.Day())
 	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	if t.Nanosecond() != 0 {
+		pd.SetFraction(float64(t.Nanosecond()) / 1e9)
+	}
+	pd.setMaterialized(t)
+	return true
+}
+
+func parseUSShortFormatInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseUSShortFormat(str, now, loc)
+	if !ok {
+		return false
+	}
+	pd.SetMonth(int(t.Month()))
+	pd.SetDay(t.Day())
 	pd.setMaterialized(t)
 	return true
 }
@@ -1075,12 +1264,13 @@ func parseShortYearUSDateWithMilitaryTimeInto(str string, now time.Time, loc *ti
 }
 
 func parseCompactTimestampInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	t, ok := parseCompactTimestamp(str, loc)
+	t, rest, ok := parseCompactTimestamp(str, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	pd.SetDate(t.Year(), int(t.Month()), t.Day())
-	digits := strings.SplitN(str, " ", 2)[0]
+	digits, _, _ := strings.Cut(str, " ")
 	if len(digits) == 14 {
 		pd.SetTime(t.Hour(), t.Minute(), t.Second())
 	}
@@ -1092,10 +1282,11 @@ func parseCompactTimestampInto(str string, now time.Time, loc *time.Location, op
 }
 
 func parseCompactTimeFormatsInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	t, ok := parseCompactTimeFormats(str, now, loc)
+	t, rest, ok := parseCompactTimeFormats(str, now, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	pd.SetTime(t.Hour(), t.Minute(), t.Second())
 	if t.Nanosecond() != 0 {
 		pd.SetFraction(float64(t.Nanosecond()) / 1e9)
@@ -1130,17 +1321,17 @@ func parseMonthNameFormatInto(str string, now time.Time, loc *time.Location, opt
 
 // parseTwoPartMonthDay matches "Mon-DD" or "DD-Mon" (e.g. Jan-15 / 15-Jan).
 func parseTwoPartMonthDay(str string) (month, day int, ok bool) {
-	parts := strings.Split(str, "-")
-	if len(parts) != 2 {
+	first, second, found := strings.Cut(str, "-")
+	if !found || strings.Contains(second, "-") {
 		return 0, 0, false
 	}
-	if m, isMonth := getMonthByNameFlex(parts[0]); isMonth {
-		if d, err := strconv.Atoi(parts[1]); err == nil && d >= 1 && d <= 31 {
+	if m, isMonth := getMonthByNameFlex(first); isMonth {
+		if d, err := strconv.Atoi(second); err == nil && d >= 1 && d <= 31 {
 			return int(m), d, true
 		}
 	}
-	if m, isMonth := getMonthByNameFlex(parts[1]); isMonth {
-		if d, err := strconv.Atoi(parts[0]); err == nil && d >= 1 && d <= 31 {
+	if m, isMonth := getMonthByNameFlex(second); isMonth {
+		if d, err := strconv.Atoi(first); err == nil && d >= 1 && d <= 31 {
 			return int(m), d, true
 		}
 	}
@@ -1178,6 +1369,18 @@ func parseHTTPLogFormatInto(str string, now time.Time, loc *time.Location, opts
 	return true
 }
 
+func parseEXIFTimestampInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseEXIFTimestamp(str, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.SetFraction(float64(t.Nanosecond()) / 1e9)
+	pd.setMaterialized(t)
+	return true
+}
+
 func parseDateTimeTZRelativeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
 	// Detect the relative-expression suffix(es) and extract them into the pd
 	// Relative block, then parse the remaining date+tz portion.
@@ -1197,7 +1400,7 @@ func parseDateTimeTZRelativeInto(str string, now time.Time, loc *time.Location,
 		for i := len(remaining) - 1; i > 0; i-- {
 			if (remaining[i] == '+' || remaining[i] == '-') && remaining[i-1] == ' ' {
 				relPart := remaining[i:]
-				relFields := strings.Fields(relPart)
+				relFields := appendFields(make([]string, 0, fieldBufLen), relPart)
 				if len(relFields) != 2 {
 					continue
 				}
@@ -1207,7 +1410,7 @@ func parseDateTimeTZRelativeInto(str string, now time.Time, loc *time.Location,
 				}
 				unit := normalizeTimeUnit(relFields[1])
 				switch unit {
-				case UnitDay, UnitWeek, UnitWeekDay, UnitMonth, UnitYear,
+				case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear,
 					UnitHour, UnitMinute, UnitSecond:
 					rels = append(rels, relExpr{amount, unit})
 					remaining = strings.TrimSpace(remaining[:i])
@@ -1249,7 +1452,7 @@ func parseDateTimeTZRelativeInto(str string, now time.Time, loc *time.Location,
 }
 
 func parseDateWithTZInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 2 {
 		return false
 	}
@@ -1273,10 +1476,11 @@ func parseDayMonthYearInto(str string, now time.Time, loc *time.Location, opts [
 	// Peek weekday prefix so we can record it as relative.weekday.
 	_, dayNum, stripped := stripWeekdayPrefix(str)
 
-	t, ok := parseDayMonthYear(str, now, loc)
+	t, rest, ok := parseDayMonthYear(str, now, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	// Recover the ORIGINAL parsed date (without the weekday-mismatch
 	// advance that parseDayMonthYear applies for StrToTime). PHP reports
 	// the unadvanced date plus relative.weekday.
@@ -1325,7 +1529,7 @@ func extractDMYFromFields(str string) (year, month, day int, ok bool) {
 	if !stripped {
 		rest = str
 	}
-	fields := strings.Fields(rest)
+	fields := appendFields(make([]string, 0, fieldBufLen), rest)
 	if len(fields) < 3 {
 		return 0, 0, 0, false
 	}
@@ -1359,7 +1563,7 @@ func extractDMYFromFields(str string) (year, month, day int, ok bool) {
 // looks like a timezone token (numeric offset, IANA name with "/", or a
 // resolvable abbreviation).
 func lastFieldLooksLikeTZ(str string) (string, bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) == 0 {
 		return "", false
 	}
@@ -1368,7 +1572,7 @@ func lastFieldLooksLikeTZ(str string) (string, bool) {
 		return "", false
 	}
 	if last[0] == '+' || last[0] == '-' {
-		if _, _, ok := parseNumericTimezoneOffset(last); ok {
+		if _, ok := parseZoneField(last); ok {
 			return last, true
 		}
 	}
@@ -1385,15 +1589,20 @@ func parseMonthYearOnlyInto(str string, now time.Time, loc *time.Location, opts
 	}
 	// PHP date_parse fills in day=1 for "Month Year" inputs.
 	pd.SetDate(t.Year(), int(t.Month()), 1)
+	pd.dayDefaulted = true
+	if strings.Count(str, " ") > 1 {
+		pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	}
 	pd.setMaterialized(t)
 	return true
 }
 
 func parseTimeBeforeDateInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	t, ok := parseTimeBeforeDate(str, loc)
+	t, rest, ok := parseTimeBeforeDate(str, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	pd.SetDate(t.Year(), int(t.Month()), t.Day())
 	pd.SetTime(t.Hour(), t.Minute(), t.Second())
 	pd.setMaterialized(t)
@@ -1537,7 +1746,7 @@ type ordinalWeekdayInfo struct {
 
 // detectOrdinalWeekdayOfMonthYear matches "<ordinal> <weekday> of <Month> <Year>".
 func detectOrdinalWeekdayOfMonthYear(str string) (info ordinalWeekdayInfo, ok bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 5 {
 		return info, false
 	}
@@ -1584,7 +1793,7 @@ func detectOrdinalWeekdayOfMonthYear(str string) (info ordinalWeekdayInfo, ok bo
 // detectOrdinalWeekdayOnly matches a bare "<ordinal> <weekday>" with no
 // month context (e.g. "third thursday").
 func detectOrdinalWeekdayOnly(str string) (info ordinalWeekdayInfo, ok bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 2 {
 		return info, false
 	}
@@ -1663,7 +1872,7 @@ func extractWeekDateParts(str string) (year, week, dayOfWeek int) {
 
 // detectFirstLastDayOfMonthYear matches "first/last day of <MonthName> [<Year>]".
 func detectFirstLastDayOfMonthYear(str string) (ordinal int, ok bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 4 || len(fields) > 5 {
 		return 0, false
 	}
@@ -1688,7 +1897,7 @@ func detectFirstLastDayOfMonthYear(str string) (ordinal int, ok bool) {
 // month/year" inputs and returns the ordinal (1 or -1), unit (month/year),
 // direction, and true. Otherwise returns false.
 func detectRelativeFirstLastDay(str string) (ordinal int, unit string, direction string, ok bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 5 {
 		return 0, "", "", false
 	}
@@ -1716,7 +1925,7 @@ func detectRelativeFirstLastDay(str string) (ordinal int, unit string, direction
 
 // --- post-pipeline Into adapters ---
 
-func parseUnixTimestampInto(str string, loc *time.Location, pd *ParsedDate) bool {
+func parseUnixTimestampInto(str string, loc *time.Location, cfg parseSettings, pd *ParsedDate) bool {
 	t, ok := tryParseUnixTimestamp(str, loc)
 	if !ok {
 		return false
@@ -1735,6 +1944,9 @@ func parseUnixTimestampInto(str string, loc *time.Location, pd *ParsedDate) bool
 			trailer := strings.TrimSpace(body[space:])
 			if trailer != "" {
 				hasExtraTZ = true
+				if !isTimezoneText(trailer) {
+					pd.leftover = trailer
+				}
 			}
 			body = body[:space]
 		}
@@ -1743,6 +1955,9 @@ func parseUnixTimestampInto(str string, loc *time.Location, pd *ParsedDate) bool
 		}
 		seconds, _ = strconv.ParseInt(body, 10, 64)
 	}
+	if hasExtraTZ && cfg.noTZDetection {
+		return false
+	}
 	pd.SetDate(1970, 1, 1)
 	pd.SetTime(0, 0, 0)
 	pd.SetFraction(0)
@@ -1760,6 +1975,16 @@ func parseUnixTimestampInto(str string, loc *time.Location, pd *ParsedDate) bool
 	return true
 }
 
+// isTimezoneText reports whether s as a whole is a zone name, abbreviation
+// or numeric UTC offset.
+func isTimezoneText(s string) bool {
+	if _, n, ok := parseNumericTimezoneOffset(s); ok && n == len(s) {
+		return true
+	}
+	_, ok := tryParseTimezone(s)
+	return ok
+}
+
 func parseKeywordInto(str string, now time.Time, loc *time.Location, pd *ParsedDate) bool {
 	switch str {
 	case "now":
@@ -1769,14 +1994,12 @@ func parseKeywordInto(str string, now time.Time, loc *time.Location, pd *ParsedD
 	case "today":
 		pd.SetTime(0, 0, 0)
 		pd.SetFraction(0)
-		y, m, d := now.Date()
-		pd.setMaterialized(time.Date(y, m, d, 0, 0, 0, 0, loc))
+		pd.setMaterialized(midnightAfter(now, 0, pd.cfg.days, loc))
 		return true
 	case "midnight":
 		pd.SetTime(0, 0, 0)
 		pd.SetFraction(0)
-		y, m, d := now.Date()
-		pd.setMaterialized(time.Date(y, m, d, 0, 0, 0, 0, loc))
+		pd.setMaterialized(midnightAfter(now, 0, pd.cfg.days, loc))
 		return true
 	case "noon":
 		pd.SetTime(12, 0, 0)
@@ -1788,18 +2011,14 @@ func parseKeywordInto(str string, now time.Time, loc *time.Location, pd *ParsedD
 		pd.SetTime(0, 0, 0)
 		pd.SetFraction(0)
 		pd.AddRelative(UnitDay, 1)
-		t := now.AddDate(0, 0, 1)
-		y, m, d := t.Date()
-		pd.setMaterialized(time.Date(y, m, d, 0, 0, 0, 0, loc))
+		pd.setMaterialized(midnightAfter(now, 1, pd.cfg.days, loc))
 		pd.relativeApplied = true
 		return true
 	case "yesterday":
 		pd.SetTime(0, 0, 0)
 		pd.SetFraction(0)
 		pd.AddRelative(UnitDay, -1)
-		t := now.AddDate(0, 0, -1)
-		y, m, d := t.Date()
-		pd.setMaterialized(time.Date(y, m, d, 0, 0, 0, 0, loc))
+		pd.setMaterialized(midnightAfter(now, -1, pd.cfg.days, loc))
 		pd.relativeApplied = true
 		return true
 	}
@@ -1823,12 +2042,24 @@ func parseDateWithRelativeTimeInto(str string, now time.Time, loc *time.Location
 	if err != nil {
 		return false
 	}
+	restPos := len(str) - len(rest)
+	if restStartsWithDate(rest) {
+		pd.AddError(restPos, "Double date specification")
+		pd.cause = &ConflictError{What: "date", First: datePart, FirstPos: 0, Second: rest, SecondPos: restPos}
+		return false
+	}
 	// Parse the remainder anchored at the parsed date so any resulting
 	// absolute time we observe is relative to the original date.
 	restSub := newParsedDate()
 	restOpts := append([]Option(nil), opts...)
 	restOpts = append(restOpts, Rel(dateResult), InTZ(loc))
 	if !dispatchStrToTime(rest, dateResult, loc, restOpts, restSub) || restSub.ErrorCount > 0 {
+		if ce := (*ConflictError)(nil); errors.As(restSub.cause, &ce) {
+			shifted := *ce
+			shifted.FirstPos += restPos
+			shifted.SecondPos += restPos
+			pd.cause = &shifted
+		}
 		return false
 	}
 
@@ -1868,12 +2099,31 @@ func parseDateWithRelativeTimeInto(str string, now time.Time, loc *time.Location
 	}
 	// StrToTime still wants the final (relative-applied) materialized time.
 	finalT, _ := restSub.Materialize(dateResult, loc)
+	// A zone alone, as in "2023-01-15 +0200", says how to read the date.
+	if !restSub.Hour.Set && restSub.Relative == nil && restSub.sourceLoc != nil {
+		finalT = inZoneWallClock(dateResult, restSub.sourceLoc)
+	}
 	pd.setMaterialized(finalT)
 	pd.relativeApplied = true
 	return true
 }
 
+// restStartsWithDate reports whether rest, the text after the date of
+// parseDateWithRelativeTimeInto, begins with a second date: a numeric date
+// or a month name followed by a day.
+func restStartsWithDate(rest string) bool {
+	word, after, _ := strings.Cut(rest, " ")
+	if looksLikeDateFormat(word) {
+		return true
+	}
+	return classifyWord(word)&kindMonth != 0 && after != "" && isDigit(after[0])
+}
+
 func tryWeekdayPrefixReparseInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	if getDayOfWeek(str) >= 0 {
+		// A bare weekday, which is no prefix: "monday" is not "mon" and "day".
+		return false
+	}
 	rest, dayNum, stripped := stripWeekdayPrefix(str)
 	if !stripped {
 		return false
@@ -1882,6 +2132,7 @@ func tryWeekdayPrefixReparseInto(str string, now time.Time, loc *time.Location,
 	switch {
 	case strings.HasPrefix(restTrimmed, "next "),
 		strings.HasPrefix(restTrimmed, "last "),
+		strings.HasPrefix(restTrimmed, "previous "),
 		strings.HasPrefix(restTrimmed, "this "):
 		return false
 	}
@@ -1899,6 +2150,7 @@ func tryWeekdayPrefixReparseInto(str string, now time.Time, loc *time.Location,
 	reparseOpts := append([]Option(nil), opts...)
 	reparseOpts = append(reparseOpts, InTZ(loc))
 	if !dispatchStrToTime(restTrimmed, now, loc, reparseOpts, sub) {
+		pd.noteUnread(sub.unread)
 		return false
 	}
 	if sub.ErrorCount > 0 {
@@ -1914,6 +2166,10 @@ func tryWeekdayPrefixReparseInto(str string, now time.Time, loc *time.Location,
 	// Materialize for StrToTime: advance to the next matching weekday when
 	// the parsed absolute date doesn't already fall on that weekday.
 	t, err := sub.Materialize(now, loc)
+	if err == nil && !sub.Hour.Set {
+		// A weekday resets the time of day: "monday +1 week" is at midnight.
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
 	if err == nil && dayNum >= 0 && int(t.Weekday()) != dayNum {
 		daysUntil := (dayNum - int(t.Weekday()) + 7) % 7
 		if daysUntil == 0 {
@@ -1937,10 +2193,11 @@ func parseCompoundExpressionInto(str string, now time.Time, opts []Option, pd *P
 }
 
 func parseOrdinalDateInto(str string, now time.Time, loc *time.Location, pd *ParsedDate) bool {
-	t, ok := parseOrdinalDate(str, now, loc)
+	t, rest, ok := parseOrdinalDate(str, now, loc)
 	if !ok {
 		return false
 	}
+	pd.leftover = rest
 	if hasFourDigitYear(str) {
 		pd.SetDate(t.Year(), int(t.Month()), t.Day())
 	} else {
@@ -2031,6 +2288,12 @@ func setTZFromName(pd *ParsedDate, name string, loc *time.Location) {
 		pd.SetTZOffset(loc, offset)
 		return
 	}
+	// "GMT+3" is an offset too.
+	if zone, ok := parseGMTOffsetZone(name); ok {
+		_, offset := time.Now().In(zone).Zone()
+		pd.SetTZOffset(loc, offset)
+		return
+	}
 	// PHP treats "Z" as an abbreviation.
 	if strings.EqualFold(name, "Z") {
 		pd.SetTZAbbreviation(time.UTC, "Z", 0, false)
@@ -2049,10 +2312,8 @@ func setTZFromName(pd *ParsedDate, name string, loc *time.Location) {
 //   - DST abbreviations (e.g. PDT, EDT) report the *standard* offset in zone
 //     and set is_dst:true, matching PHP's timelib.
 func applyAbbreviationTZ(pd *ParsedDate, loc *time.Location, abbr string, offset int) {
-	upper := strings.ToUpper(abbr)
-
 	// UTC: zone_type 3 with both tz_id and tz_abbr.
-	if upper == "UTC" {
+	if strings.EqualFold(abbr, "UTC") {
 		pd.IsLocaltime = true
 		pd.ZoneType = 3
 		pd.Zone = 0
@@ -2062,6 +2323,7 @@ func applyAbbreviationTZ(pd *ParsedDate, loc *time.Location, abbr string, offset
 		pd.sourceLoc = loc
 		return
 	}
+	upper := strings.ToUpper(abbr)
 
 	// Check whether this abbreviation is a DST form. PHP emits the standard
 	// offset for DST abbreviations and sets is_dst:true.
@@ -0,0 +1,119 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+// TestFirstByteDispatch checks that restricting formatParsers by the leading
+// byte class selects the same entry as walking the whole pipeline.
+func TestFirstByteDispatch(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	first := func(str string, indexes []int) int {
+		for _, i := range indexes {
+			if formatParsers[i].parse(str, now, time.UTC, nil, newParsedDate()) {
+				return i
+			}
+		}
+		return -1
+	}
+	all := make([]int, len(formatParsers))
+	for i := range all {
+		all[i] = i
+	}
+
+	for _, file := range []string{"testdata/strtotime_tests.csv", "testdata/strtotime_invalid.csv"} {
+		for _, rec := range loadCSV(t, file) {
+			str := normalizeInput(rec[0])
+			if str == "" {
+				continue // rejected before dispatch
+			}
+			want := first(str, all)
+			got := first(str, formatParsersByClass[classifyFirstByte(str)])
+			if got != want {
+				t.Errorf("%q: dispatched to parser %d, full pipeline picks %d", str, got, want)
+			}
+		}
+	}
+}
+
+// TestFormatParsersOrderIndependent checks that inputs of the same layout
+// that need different parsers keep their result whatever was parsed before.
+func TestFormatParsersOrderIndependent(t *testing.T) {
+	base := Rel(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	tests := []struct {
+		a, b         string
+		wantA, wantB time.Time
+	}{
+		{"15.01.24", "10.43.31",
+			time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 10, 43, 31, 0, time.UTC)},
+		{"12345-02-30", "13342-02-01",
+			time.Date(12345, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 13, 34, 2, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		for _, order := range [][2]string{{tt.a, tt.b}, {tt.b, tt.a}} {
+			for _, input := range order {
+				want := tt.wantA
+				if input == tt.b {
+					want = tt.wantB
+				}
+				got, err := StrToTime(input, base)
+				if err != nil || !got.Equal(want) {
+					t.Errorf("%q then %q: StrToTime(%q) = %v, %v; want %v", order[0], order[1], input, got, err, want)
+				}
+			}
+		}
+	}
+}
+
+func TestEXIFTimestamp(t *testing.T) {
+	tests := map[string]time.Time{
+		"2003:10:29 10:11:12":     time.Date(2003, 10, 29, 10, 11, 12, 0, time.UTC),
+		"2003:10:29 10:11:12.25":  time.Date(2003, 10, 29, 10, 11, 12, 250000000, time.UTC),
+		"2024:02:29 00:00:00.001": time.Date(2024, 2, 29, 0, 0, 0, 1000000, time.UTC),
+	}
+	for input, want := range tests {
+		got, ok := parseEXIFTimestamp(input, time.UTC)
+		if !ok || !got.Equal(want) {
+			t.Errorf("parseEXIFTimestamp(%q) = %v, %v, want %v", input, got, ok, want)
+		}
+	}
+
+	for _, input := range []string{
+		"2003:02:30 10:11:12",
+		"2003:10:29 24:00:00",
+		"2003:10:29 10:11",
+		"2003:1:29 10:11:12",
+		"2003:10:29 10:11:12.",
+	} {
+		if got, ok := parseEXIFTimestamp(input, time.UTC); ok {
+			t.Errorf("parseEXIFTimestamp(%q) = %v, want no match", input, got)
+		}
+	}
+}
+
+func TestCompactDateWithTime(t *testing.T) {
+	plus2 := time.FixedZone("", 2*3600)
+	tests := map[string]time.Time{
+		"20230115 10:30":            time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"20230115 9:05:07":          time.Date(2023, 1, 15, 9, 5, 7, 0, time.UTC),
+		"20230115 10:30:45.25":      time.Date(2023, 1, 15, 10, 30, 45, 250000000, time.UTC),
+		"20230115 10:30:45.5 +0200": time.Date(2023, 1, 15, 10, 30, 45, 500000000, plus2),
+	}
+	for input, want := range tests {
+		pd := newParsedDate()
+		if !parseCompactDateWithTimeInto(input, time.Time{}, time.UTC, nil, pd) {
+			t.Errorf("parseCompactDateWithTimeInto(%q) did not match", input)
+			continue
+		}
+		if got := pd.materialized; !got.Equal(want) {
+			t.Errorf("parseCompactDateWithTimeInto(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"20230230 10:00", "20230115 24:00", "20230115 10:30:45xyz", "20230115 10:30 nowhere"} {
+		if parseCompactDateWithTimeInto(input, time.Time{}, time.UTC, nil, newParsedDate()) {
+			t.Errorf("parseCompactDateWithTimeInto(%q) matched, want no match", input)
+		}
+	}
+}
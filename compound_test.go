@@ -1,6 +1,7 @@
 package strtotime
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -29,3 +30,26 @@ func TestComplexCompoundExpressions(t *testing.T) {
 		})
 	}
 }
+
+func TestCompoundOperators(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []int
+	}{
+		{"next year + 4 days", []int{10}},
+		{"next year+4 days", []int{9}},
+		{"tomorrow-1hour", []int{8}},
+		{"+1 week -2 days +3 hours", []int{8, 16}},
+		{"jan-15-2006 +1 day", []int{12}},
+		{"2023-01-15", nil},
+		{"2023-01-15 10:00 +0500", nil},
+		{"+1 week", nil},
+		{"10/oct/2000:13:55:36 -0700", nil},
+	}
+	for _, tt := range tests {
+		got := compoundOperators(tt.input, nil)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("compoundOperators(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
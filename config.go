@@ -0,0 +1,77 @@
+package strtotime
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// defaultOptions holds the options set by SetDefaultOptions.
+var defaultOptions atomic.Pointer[[]Option]
+
+// SetDefaultOptions sets options applied to every StrToTime call (including
+// those made through ParserBuffer, LineParser and Cache) before the options
+// passed to the call itself, which take precedence. Calling it again
+// replaces the previous defaults; calling it with no options clears them.
+//
+// It is meant to be called once at startup, e.g. to set the zone of a
+// service, and is safe to call concurrently with parsing.
+func SetDefaultOptions(opts ...Option) {
+	if len(opts) == 0 {
+		defaultOptions.Store(nil)
+		return
+	}
+	d := cloneOptions(opts)
+	defaultOptions.Store(&d)
+}
+
+// withDefaults returns opts preceded by the options set by SetDefaultOptions.
+// opts is returned as-is when no defaults are set.
+func withDefaults(opts []Option) []Option {
+	d := defaultOptions.Load()
+	if d == nil {
+		return opts
+	}
+	if len(opts) == 0 {
+		return *d
+	}
+	return append((*d)[:len(*d):len(*d)], opts...)
+}
+
+// Config is a reusable set of options. Services that always parse with the
+// same zone and behavior switches can build one Config at startup and call
+// Parse, instead of passing the same options to every StrToTime call.
+// Defaults set by SetDefaultOptions do not apply to a Config.
+//
+// A Config is immutable and safe for concurrent use.
+type Config struct {
+	opts []Option
+}
+
+// NewConfig returns a Config parsing with opts.
+func NewConfig(opts ...Option) *Config {
+	return &Config{opts: cloneOptions(opts)}
+}
+
+// cloneOptions returns a copy of opts with no spare capacity, so that the
+// appends done while parsing never write into a shared slice.
+func cloneOptions(opts []Option) []Option {
+	c := make([]Option, len(opts))
+	copy(c, opts)
+	return c
+}
+
+// Parse parses str like StrToTime with the options of c.
+func (c *Config) Parse(str string) (time.Time, error) {
+	return strToTime(str, c.opts, newParsedDate())
+}
+
+// StrToTime parses str with the options of c followed by opts, which take
+// precedence.
+func (c *Config) StrToTime(str string, opts ...Option) (time.Time, error) {
+	if len(opts) > 0 {
+		opts = append(c.opts[:len(c.opts):len(c.opts)], opts...)
+	} else {
+		opts = c.opts
+	}
+	return strToTime(str, opts, newParsedDate())
+}
@@ -0,0 +1,78 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfig(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	paris, err := loadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewConfig(Rel(base), InTZ(paris))
+
+	got, err := c.Parse("tomorrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 2, 0, 0, 0, 0, paris); !got.Equal(want) {
+		t.Errorf("Parse(tomorrow) = %v, want %v", got, want)
+	}
+
+	// Per-call options override the Config ones.
+	got, err = c.StrToTime("tomorrow", InTZ(time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StrToTime(tomorrow, UTC) = %v, want %v", got, want)
+	}
+
+	if _, err := NewConfig(DisableTZDetection()).Parse("2024-01-15 10:00 EST"); err == nil {
+		t.Error("expected an error with DisableTZDetection")
+	}
+}
+
+func TestSetDefaultOptions(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tokyo, err := loadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefaultOptions(InTZ(tokyo))
+	defer SetDefaultOptions()
+
+	got, err := StrToTime("2024-01-15 10:00", Rel(base))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 15, 10, 0, 0, 0, tokyo); !got.Equal(want) {
+		t.Errorf("StrToTime with default zone = %v, want %v", got, want)
+	}
+
+	var buf ParserBuffer
+	got, err = buf.StrToTime("2024-01-15 10:00", InTZ(time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("per-call zone should override the default: got %v, want %v", got, want)
+	}
+
+	// A Config is not affected by the defaults.
+	got, err = NewConfig(InTZ(time.UTC)).Parse("2024-01-15 10:00 +1 day")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Config.Parse = %v, want %v", got, want)
+	}
+
+	SetDefaultOptions()
+	got, _ = StrToTime("2024-01-15 10:00", InTZ(time.UTC))
+	if want := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("after clearing defaults = %v, want %v", got, want)
+	}
+}
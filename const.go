@@ -2,14 +2,15 @@ package strtotime
 
 // Time unit constants
 const (
-	UnitDay     = "day"
-	UnitWeek    = "week"
-	UnitWeekDay = "weekday"
-	UnitMonth   = "month"
-	UnitYear    = "year"
-	UnitHour    = "hour"
-	UnitMinute  = "minute"
-	UnitSecond  = "second"
+	UnitDay       = "day"
+	UnitWeek      = "week"
+	UnitFortnight = "fortnight"
+	UnitWeekDay   = "weekday"
+	UnitMonth     = "month"
+	UnitYear      = "year"
+	UnitHour      = "hour"
+	UnitMinute    = "minute"
+	UnitSecond    = "second"
 
 	// Direction constants
 	DirectionNext = "next"
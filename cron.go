@@ -0,0 +1,346 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears bounds the search for the next run of a Cron. February
+// 29th, the rarest day a schedule can name, recurs within 8 years.
+const cronSearchYears = 10
+
+// Cron is a schedule written in cron syntax. Each field is kept as a set of
+// the values it matches, one bit per value.
+type Cron struct {
+	second, minute, hour uint64
+	dom                  uint64 // days of the month, 1 to 31
+	month                uint64 // 1 to 12
+	dow                  uint64 // days of the week, Sunday is 0
+	domAny, dowAny       bool   // the field was "*" or "?"
+	seconds              bool   // the expression has a seconds field
+}
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // names of the values from min, if any
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: []string{
+		"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: []string{
+		"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// cronMacros are the shorthands accepted in place of the five fields.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a cron expression: five fields (minute, hour, day of
+// month, month and day of week), six with a leading seconds field, or one of
+// the macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and
+// @hourly. Fields accept "*", values, ranges ("1-5"), lists ("1,15") and
+// steps ("*/15", "0-30/10"); months and days of the week may be given by
+// their three-letter English names, and Sunday as either 0 or 7. As in
+// classic cron, when both the day of month and the day of week are
+// restricted a day matching either one is a match.
+func ParseCron(expr string) (*Cron, error) {
+	fields := strings.Fields(strings.ToLower(expr))
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		macro, ok := cronMacros[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("%w %q: unknown macro", ErrInvalidSchedule, expr)
+		}
+		fields = strings.Fields(macro)
+	}
+
+	c := &Cron{}
+	switch len(fields) {
+	case 5:
+		c.second = 1
+	case 6:
+		c.seconds = true
+		var err error
+		if c.second, err = cronSecond.parse(fields[0]); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidSchedule, expr, err)
+		}
+		fields = fields[1:]
+	default:
+		return nil, fmt.Errorf("%w %q: expected 5 or 6 fields", ErrInvalidSchedule, expr)
+	}
+
+	var err error
+	for i, f := range []struct {
+		field *cronField
+		set   *uint64
+	}{
+		{&cronMinute, &c.minute},
+		{&cronHour, &c.hour},
+		{&cronDom, &c.dom},
+		{&cronMonth, &c.month},
+		{&cronDow, &c.dow},
+	} {
+		if *f.set, err = f.field.parse(fields[i]); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidSchedule, expr, err)
+		}
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	c.domAny = fields[2] == "*" || fields[2] == "?"
+	c.dowAny = fields[4] == "*" || fields[4] == "?"
+	return c, nil
+}
+
+// parse returns the set of values matched by s.
+func (f *cronField) parse(s string) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(s, ",") {
+		rng, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n < 1 || n > f.max {
+				return 0, fmt.Errorf("invalid %s step %q", f.name, stepText)
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" && rng != "?" {
+			loText, hiText, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(loText); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = f.value(hiText); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+			if hi < lo {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rng)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// value reads a single value of the field, as a number or a name.
+func (f *cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if s == name {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+	return n, nil
+}
+
+// format writes set back in cron syntax, for values up to hi: "*" when it
+// holds every value, a step when three or more values are evenly spaced
+// from the lowest possible one, and a list of values and ranges otherwise.
+func (f *cronField) format(set uint64, hi int) string {
+	full := (uint64(1)<<(hi+1) - 1) &^ (uint64(1)<<f.min - 1)
+	if set == full {
+		return "*"
+	}
+	if step := cronStep(set, f.min, hi); step > 1 && bits.OnesCount64(set) > 2 {
+		return "*/" + strconv.Itoa(step)
+	}
+
+	var b strings.Builder
+	for v := f.min; v <= hi; v++ {
+		if set&(1<<v) == 0 {
+			continue
+		}
+		end := v
+		for end < hi && set&(1<<(end+1)) != 0 {
+			end++
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Itoa(v))
+		if end > v+1 {
+			b.WriteString("-" + strconv.Itoa(end))
+			v = end
+		}
+	}
+	return b.String()
+}
+
+// cronStep returns n when set holds exactly lo, lo+n, lo+2n... up to hi,
+// or 0.
+func cronStep(set uint64, lo, hi int) int {
+	if set&(1<<lo) == 0 || bits.OnesCount64(set) < 2 {
+		return 0
+	}
+	step := bits.TrailingZeros64(set>>(lo+1)) + 1
+	var want uint64
+	for v := lo; v <= hi; v += step {
+		want |= 1 << v
+	}
+	if want != set {
+		return 0
+	}
+	return step
+}
+
+// String returns the schedule in cron syntax, with the seconds field if
+// it was parsed with one.
+func (c *Cron) String() string {
+	fields := []string{
+		cronMinute.format(c.minute, 59),
+		cronHour.format(c.hour, 23),
+		cronDom.format(c.dom, 31),
+		cronMonth.format(c.month, 12),
+		cronDow.format(c.dow, 6),
+	}
+	if c.domAny {
+		fields[2] = "*"
+	}
+	if c.dowAny {
+		fields[4] = "*"
+	}
+	if c.seconds {
+		fields = append([]string{cronSecond.format(c.second, 59)}, fields...)
+	}
+	return strings.Join(fields, " ")
+}
+
+// matchesDay reports whether the day of t is in the schedule.
+func (c *Cron) matchesDay(t time.Time) bool {
+	dom := c.dom&(1<<t.Day()) != 0
+	dow := c.dow&(1<<t.Weekday()) != 0
+	if !c.domAny && !c.dowAny {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Next returns the first time after t matched by the schedule, in the
+// location of t, or the zero time if there is none in the next ten years
+// (as for "0 0 30 2 *").
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	y, m, d := t.Date()
+	hh, mm, ss := t.Clock()
+	if c.seconds {
+		t = cronAdvance(t, time.Date(y, m, d, hh, mm, ss+1, 0, loc), time.Second)
+	} else {
+		t = cronAdvance(t, time.Date(y, m, d, hh, mm+1, 0, 0, loc), time.Minute)
+	}
+
+	limit := t.Year() + cronSearchYears
+	for t.Year() <= limit {
+		y, m, d = t.Date()
+		hh, mm, ss = t.Clock()
+		switch {
+		case c.month&(1<<m) == 0:
+			t = time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchesDay(t):
+			t = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<hh) == 0:
+			t = cronAdvance(t, time.Date(y, m, d, hh+1, 0, 0, 0, loc), time.Hour)
+		case c.minute&(1<<mm) == 0:
+			t = cronAdvance(t, time.Date(y, m, d, hh, mm+1, 0, 0, loc), time.Minute)
+		case c.second&(1<<ss) == 0:
+			t = cronAdvance(t, time.Date(y, m, d, hh, mm, ss+1, 0, loc), time.Second)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// cronAdvance returns next if it is after t. Otherwise, which happens when the
+// wall clock repeats at the end of DST, it returns t moved to the start of
+// the next step.
+func cronAdvance(t, next time.Time, step time.Duration) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(step).Truncate(step)
+}
+
+// looksLikeCron reports whether str is written in cron syntax rather than
+// as a date: a macro, or five or six fields made of digits, "*", "?", ",",
+// "-" and "/", with names only in the month and day of week fields.
+func looksLikeCron(str string) bool {
+	fields := strings.Fields(str)
+	if len(fields) == 1 {
+		return strings.HasPrefix(fields[0], "@")
+	}
+	if len(fields) != 5 && len(fields) != 6 {
+		return false
+	}
+	for i, f := range fields {
+		names := i >= len(fields)-2
+		for j := 0; j < len(f); j++ {
+			c := f[j]
+			switch {
+			case isDigit(c), c == '*', c == '?', c == ',', c == '-', c == '/':
+			case names && ((c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')):
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ParseSchedule returns the next time str is due after the base time (see
+// Rel), so that schedulers can take cron expressions, recurrences or date
+// expressions alike. Cron expressions (see ParseCron) and recurrences such
+// as "every weekday at 9am" (see ParseRecurrence) are evaluated in the
+// location set with InTZ, or the local one; anything else is parsed by
+// StrToTime with the same options.
+func ParseSchedule(str string, opts ...Option) (time.Time, error) {
+	var c *Cron
+	var err error
+	switch {
+	case looksLikeCron(str):
+		c, err = ParseCron(str)
+	case looksLikeRecurrence(str):
+		c, err = ParseRecurrence(str)
+	default:
+		return StrToTime(str, opts...)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	now, _ := resolveOptions(withDefaults(opts))
+	next := c.Next(now)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("%w %q: no run in the next %d years", ErrInvalidSchedule, str, cronSearchYears)
+	}
+	return next, nil
+}
@@ -0,0 +1,115 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr, want string
+	}{
+		{"0 9 * * MON", "0 9 * * 1"},
+		{"*/15 * * * *", "*/15 * * * *"},
+		{"30 0 9 * * mon-fri", "30 0 9 * * 1-5"},
+		{"0 0 1 jan,jul *", "0 0 1 1,7 *"},
+		{"0 0 1 */4 *", "0 0 1 */4 *"},
+		{"0-30/10 8-17 * * 1-5", "0,10,20,30 8-17 * * 1-5"},
+		{"0 0 * * 7", "0 0 * * 0"},
+		{"0 0 ? * sun", "0 0 * * 0"},
+		{"5/20 * * * *", "5,25,45 * * * *"},
+		{"@weekly", "0 0 * * 0"},
+		{"@Daily", "0 0 * * *"},
+	}
+	for _, tt := range tests {
+		c, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Errorf("ParseCron(%q): %v", tt.expr, err)
+			continue
+		}
+		if got := c.String(); got != tt.want {
+			t.Errorf("ParseCron(%q).String() = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+
+	for _, expr := range []string{"", "* * * *", "61 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"* * * * 8", "5-1 * * * *", "*/0 * * * *", "* * * * funday", "@often"} {
+		if _, err := ParseCron(expr); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("ParseCron(%q) error = %v, want ErrInvalidSchedule", expr, err)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC) // a Friday
+	tests := []struct {
+		expr, want string
+	}{
+		{"0 9 * * mon", "2024-03-04 09:00:00"},
+		{"*/15 * * * *", "2024-03-01 10:15:00"},
+		{"0 10 * * *", "2024-03-02 10:00:00"},
+		{"* * * * * *", "2024-03-01 10:00:01"},
+		{"0 0 29 2 *", "2028-02-29 00:00:00"},
+		{"0 12 13 * fri", "2024-03-01 12:00:00"}, // the 13th or a Friday
+		{"0 0 31 * *", "2024-03-31 00:00:00"},
+		{"0 0 1 */3 *", "2024-04-01 00:00:00"},
+	}
+	for _, tt := range tests {
+		c, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseCron(%q): %v", tt.expr, err)
+		}
+		if got := c.Next(base).Format("2006-01-02 15:04:05"); got != tt.want {
+			t.Errorf("%q: Next = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+
+	c, _ := ParseCron("0 0 30 2 *")
+	if got := c.Next(base); !got.IsZero() {
+		t.Errorf("Next of a schedule without runs = %v, want the zero time", got)
+	}
+
+	// 02:30 doesn't exist on March 10th, 2024 in New York, and 01:30 comes
+	// twice on November 3rd.
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("America/New_York not available")
+	}
+	c, _ = ParseCron("30 2 * * *")
+	if got := c.Next(time.Date(2024, 3, 9, 12, 0, 0, 0, ny)); got.Day() != 11 || got.Hour() != 2 {
+		t.Errorf("30 2 * * * across the spring gap = %v, want March 11th at 02:30", got)
+	}
+	c, _ = ParseCron("*/30 * * * *")
+	first := time.Date(2024, 11, 3, 1, 30, 0, 0, ny)
+	next := c.Next(first)
+	if !next.After(first) {
+		t.Errorf("*/30 * * * * after %v = %v, want a later time", first, next)
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for input, want := range map[string]string{
+		"0 9 * * MON":   "2024-03-04 09:00:00",
+		"@monthly":      "2024-04-01 00:00:00",
+		"next monday":   "2024-03-04 00:00:00",
+		"tomorrow 9am":  "2024-03-02 09:00:00",
+		"2024-06-01 12": "2024-06-01 12:00:00",
+	} {
+		got, err := ParseSchedule(input, Rel(base))
+		if err != nil {
+			t.Errorf("ParseSchedule(%q): %v", input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != want {
+			t.Errorf("ParseSchedule(%q) = %s, want %s", input, s, want)
+		}
+	}
+
+	if _, err := ParseSchedule("61 * * * *", Rel(base)); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("ParseSchedule(\"61 * * * *\") error = %v, want ErrInvalidSchedule", err)
+	}
+}
@@ -4,34 +4,23 @@ import (
 	"strconv"
 	"strings"
 	"time"
-	"unicode"
 )
 
 // parseISOFormat tries to parse a ISO format date (YYYY-MM-DD or D-M-YYYY)
 func parseISOFormat(str string, loc *time.Location) (time.Time, bool) {
-	if strings.Count(str, "-") != 2 {
-		return time.Time{}, false
-	}
-
-	parts := strings.Split(str, "-")
-	if len(parts) != 3 {
-		return time.Time{}, false
-	}
-
 	// All parts must be numeric
-	for _, p := range parts {
-		if !isAllDigits(p) || len(p) == 0 {
-			return time.Time{}, false
-		}
+	p0, p1, p2, ok := splitDigitFields(str, '-')
+	if !ok {
+		return time.Time{}, false
 	}
 
-	first, _ := strconv.Atoi(parts[0])
-	second, _ := strconv.Atoi(parts[1])
-	third, _ := strconv.Atoi(parts[2])
+	first := digitsValue(p0)
+	second := digitsValue(p1)
+	third := digitsValue(p2)
 
 	var year, month, day int
 
-	if len(parts[0]) >= 4 {
+	if len(p0) >= 4 {
 		// YYYY-MM-DD (ISO format)
 		year, month, day = first, second, third
 		// PHP doesn't support years > 9999 in YYYY-MM-DD format;
@@ -39,7 +28,7 @@ func parseISOFormat(str string, loc *time.Location) (time.Time, bool) {
 		if year > 9999 {
 			return time.Time{}, false
 		}
-	} else if len(parts[2]) >= 4 {
+	} else if len(p2) >= 4 {
 		// D-M-YYYY (European style with dashes)
 		day, month, year = first, second, third
 	} else {
@@ -61,25 +50,15 @@ func parseISOFormat(str string, loc *time.Location) (time.Time, bool) {
 
 // parseSlashFormat tries to parse a slash format date (YYYY/MM/DD)
 func parseSlashFormat(str string, loc *time.Location) (time.Time, bool) {
-	if strings.Count(str, "/") != 2 {
-		return time.Time{}, false
-	}
-
-	parts := strings.Split(str, "/")
-	if len(parts) != 3 || len(parts[0]) < 4 {
-		return time.Time{}, false
-	}
-
 	// All parts must be numeric
-	for _, p := range parts {
-		if !isAllDigits(p) || len(p) == 0 {
-			return time.Time{}, false
-		}
+	p0, p1, p2, ok := splitDigitFields(str, '/')
+	if !ok || len(p0) < 4 {
+		return time.Time{}, false
 	}
 
-	year, _ := strconv.Atoi(parts[0])
-	month, _ := strconv.Atoi(parts[1])
-	day, _ := strconv.Atoi(parts[2])
+	year := digitsValue(p0)
+	month := digitsValue(p1)
+	day := digitsValue(p2)
 
 	if !IsValidDate(year, month, day) {
 		return time.Time{}, false
@@ -88,74 +67,70 @@ func parseSlashFormat(str string, loc *time.Location) (time.Time, bool) {
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
 }
 
-// parseUSFormat tries to parse a US format date (MM/DD/YYYY)
+// parseUSFormat tries to parse a US format date (MM/DD/YYYY or M/D/YY)
 func parseUSFormat(str string, loc *time.Location) (time.Time, bool) {
-	if strings.Count(str, "/") != 2 {
+	// All parts must be numeric
+	p0, p1, p2, ok := splitDigitFields(str, '/')
+	if !ok || (len(p2) < 4 && (len(p2) != 2 || len(p0) > 2)) {
 		return time.Time{}, false
 	}
 
-	parts := strings.Split(str, "/")
-	if len(parts) != 3 || len(parts[2]) < 4 {
-		return time.Time{}, false
+	month := digitsValue(p0)
+	day := digitsValue(p1)
+	year := digitsValue(p2)
+	if len(p2) == 2 {
+		year = parseTwoDigitYear(year)
 	}
 
-	// All parts must be numeric
-	for _, p := range parts {
-		if !isAllDigits(p) || len(p) == 0 {
-			return time.Time{}, false
-		}
+	if !IsValidDate(year, month, day) {
+		return time.Time{}, false
 	}
 
-	month, _ := strconv.Atoi(parts[0])
-	day, _ := strconv.Atoi(parts[1])
-	year, _ := strconv.Atoi(parts[2])
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
+}
 
-	if !IsValidDate(year, month, day) {
+// parseUSShortFormat tries to parse a US format date without a year (MM/DD
+// or M/D), which falls in the year of now.
+func parseUSShortFormat(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	month, day, ok := strings.Cut(str, "/")
+	if !ok || month == "" || day == "" || len(month) > 2 || len(day) > 2 || !isAllDigits(month) || !isAllDigits(day) {
 		return time.Time{}, false
 	}
 
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
+	year := now.In(loc).Year()
+	m, d := digitsValue(month), digitsValue(day)
+	if !IsValidDate(year, m, d) {
+		return time.Time{}, false
+	}
+
+	return time.Date(year, time.Month(m), d, 0, 0, 0, 0, loc), true
 }
 
 // parseEuropeanFormat tries to parse a European format date (DD.MM.YY or DD.MM.YYYY)
 func parseEuropeanFormat(str string, loc *time.Location) (time.Time, bool) {
-	if strings.Count(str, ".") == 2 {
-		parts := strings.Split(str, ".")
-		if len(parts) == 3 {
-			// Validate each part contains only digits
-			for _, part := range parts {
-				for _, char := range part {
-					if !unicode.IsDigit(char) {
-						return time.Time{}, false
-					}
-				}
-			}
-
-			// Parse the components
-			day, dayErr := strconv.Atoi(parts[0])
-			month, monthErr := strconv.Atoi(parts[1])
-			year, yearErr := strconv.Atoi(parts[2])
-
-			// Check for parsing errors
-			if yearErr != nil || monthErr != nil || dayErr != nil {
-				return time.Time{}, false
-			}
+	// Validate each part contains only digits
+	p0, p1, p2, ok := splitDigitFields(str, '.')
+	if !ok {
+		return time.Time{}, false
+	}
 
-			// Handle 2-digit years
-			if year < 100 {
-				year = parseTwoDigitYear(year)
-			}
+	// Parse the components
+	day := digitsValue(p0)
+	month := digitsValue(p1)
+	year := digitsValue(p2)
 
-			// Validate date components
-			if !IsValidDate(year, month, day) {
-				return time.Time{}, false
-			}
+	// Handle 2-digit years
+	if len(p2) <= 2 {
+		year = parseTwoDigitYear(year)
+	}
 
-			// Valid European format date
-			return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
-		}
+	// Validate date components
+	if !IsValidDate(year, month, day) {
+		return time.Time{}, false
 	}
-	return time.Time{}, false
+
+	// Valid European format date
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
 }
 
 // parseTwoDigitYear normalizes 2-digit years according to standard practice
@@ -181,13 +156,12 @@ func parseDateTimeFormat(str string, loc *time.Location) (time.Time, bool) {
 	} else if strings.HasSuffix(restLower, "am") || strings.HasSuffix(restLower, "pm") {
 		ampm = restLower[len(restLower)-2:]
 		rest = strings.TrimSpace(rest[:len(rest)-2])
-	} else {
-		// Check for " AM" or " PM" as separate word
-		upperRest := strings.ToUpper(rest)
-		if strings.HasSuffix(upperRest, " AM") || strings.HasSuffix(upperRest, " PM") {
-			ampm = strings.ToLower(upperRest[len(upperRest)-2:])
-			rest = strings.TrimSpace(rest[:len(rest)-3])
-		}
+	}
+
+	// 12-hour times may separate their fields with periods ("3.30pm") and
+	// have no fraction.
+	if ampm != "" {
+		rest = strings.ReplaceAll(rest, ".", ":")
 	}
 
 	// Parse time using the ISO 8601 time parser (handles HH:MM:SS and fractional seconds)
@@ -198,6 +172,9 @@ func parseDateTimeFormat(str string, loc *time.Location) (time.Time, bool) {
 
 	// Apply AM/PM
 	if ampm != "" {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, false
+		}
 		hour = applyAMPM(hour, ampm)
 	}
 
@@ -240,19 +217,19 @@ func parseYearMonthFormat(str string, loc *time.Location) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
-	parts := strings.SplitN(str, "-", 2)
-	if len(parts) != 2 || !isAllDigits(parts[0]) || !isAllDigits(parts[1]) {
+	yearStr, numStr, _ := strings.Cut(str, "-")
+	if !isAllDigits(yearStr) || !isAllDigits(numStr) {
 		return time.Time{}, false
 	}
-	if len(parts[0]) < 4 {
+	if len(yearStr) < 4 {
 		return time.Time{}, false
 	}
 
-	year, _ := strconv.Atoi(parts[0])
-	dayOrMonth, _ := strconv.Atoi(parts[1])
+	year, _ := strconv.Atoi(yearStr)
+	dayOrMonth, _ := strconv.Atoi(numStr)
 
 	// ISO ordinal date: YYYY-DDD (3 digits, day of year 001-366)
-	if len(parts[1]) == 3 && dayOrMonth >= 1 && dayOrMonth <= 366 {
+	if len(numStr) == 3 && dayOrMonth >= 1 && dayOrMonth <= 366 {
 		t := time.Date(year, 1, 1, 0, 0, 0, 0, loc).AddDate(0, 0, dayOrMonth-1)
 		if t.Year() == year { // ensure doy didn't overflow into next year
 			return t, true
@@ -269,9 +246,9 @@ func parseYearMonthFormat(str string, loc *time.Location) (time.Time, bool) {
 }
 
 // parseSignedYear parses "-YYYY-MM-DD [HH:MM:SS [TZ]]" or "+YYYY-MM-DD[T][HH:MM:SS [TZ]]" format.
-func parseSignedYear(str string, loc *time.Location) (time.Time, bool) {
+func parseSignedYear(str string, loc *time.Location) (time.Time, string, bool) {
 	if len(str) < 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	var sign int
 	switch str[0] {
@@ -280,7 +257,7 @@ func parseSignedYear(str string, loc *time.Location) (time.Time, bool) {
 	case '+':
 		sign = 1
 	default:
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	rest := str[1:]
 
@@ -299,47 +276,49 @@ func parseSignedYear(str string, loc *time.Location) (time.Time, bool) {
 	}
 
 	if strings.Count(datePart, "-") != 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	parts := strings.Split(datePart, "-")
 	if len(parts) != 3 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	if !isAllDigits(parts[0]) || !isAllDigits(parts[1]) || !isAllDigits(parts[2]) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	// Positive years must have >= 4 digits to differentiate from "+1 week" etc.
 	if sign > 0 && len(parts[0]) < 4 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	year, _ := strconv.Atoi(parts[0])
 	month, _ := strconv.Atoi(parts[1])
 	day, _ := strconv.Atoi(parts[2])
 	if month < 1 || month > 12 || day < 1 || day > 31 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	hour, minute, second, nanos := 0, 0, 0, 0
 	tzLoc := loc
+	unread := ""
 
 	if timeTzPart != "" {
-		hour, minute, second, nanos, tzLoc = parseTimeTzSuffix(timeTzPart, loc)
+		hour, minute, second, nanos, tzLoc, unread = parseTimeTzSuffix(timeTzPart, loc)
 	}
 
 	result := time.Date(sign*year, time.Month(month), day, hour, minute, second, nanos, tzLoc)
 	result = fixOverflowedTime(result, sign*year, month, day, hour, minute, second, tzLoc)
-	return result, true
+	return result, unread, true
 }
 
-// parseTimeTzSuffix parses "HH:MM:SS[.frac] [TZ]" from the suffix of a date string
-func parseTimeTzSuffix(s string, loc *time.Location) (int, int, int, int, *time.Location) {
+// parseTimeTzSuffix parses "HH:MM:SS[.frac] [TZ]" from the suffix of a date
+// string. The last result is the text after them, which it leaves unread.
+func parseTimeTzSuffix(s string, loc *time.Location) (int, int, int, int, *time.Location, string) {
 	hour, minute, second, nanos := 0, 0, 0, 0
 	tzLoc := loc
 
 	h, m, sec, consumed, ok := parseFlexTime(s)
 	if !ok {
-		return hour, minute, second, nanos, tzLoc
+		return hour, minute, second, nanos, tzLoc, s
 	}
 	hour, minute, second = h, m, sec
 	remaining := s[consumed:]
@@ -366,25 +345,25 @@ func parseTimeTzSuffix(s string, loc *time.Location) (int, int, int, int, *time.
 
 	remaining = strings.TrimSpace(remaining)
 	if remaining != "" {
-		if parsed, _, ok := parseNumericTimezoneOffset(remaining); ok {
+		if parsed, n, ok := parseNumericTimezoneOffset(remaining); ok {
 			tzLoc = parsed
+			remaining = strings.TrimSpace(remaining[n:])
 		} else if parsed, found := tryParseTimezone(remaining); found {
 			tzLoc = parsed
+			remaining = ""
 		}
 	}
 
-	return hour, minute, second, nanos, tzLoc
+	return hour, minute, second, nanos, tzLoc, remaining
 }
 
 // parseShortYearUSDateWithMilitaryTime parses "MM/DD/YY HHMM" format
 func parseShortYearUSDateWithMilitaryTime(str string, loc *time.Location) (time.Time, bool) {
-	parts := strings.SplitN(str, " ", 2)
-	if len(parts) != 2 {
+	datePart, timePart, found := strings.Cut(str, " ")
+	if !found {
 		return time.Time{}, false
 	}
-
-	datePart := parts[0]
-	timePart := strings.TrimSpace(parts[1])
+	timePart = strings.TrimSpace(timePart)
 
 	// Date must have exactly 2 slashes
 	if strings.Count(datePart, "/") != 2 {
@@ -482,6 +461,12 @@ func looksLikeDateFormat(s string) bool {
 		}
 	}
 
+	// M/D
+	if month, day, ok := strings.Cut(s, "/"); ok && len(month) <= 2 && len(day) <= 2 &&
+		month != "" && day != "" && isAllDigits(month) && isAllDigits(day) {
+		return true
+	}
+
 	// DD.MM.YY or DD.MM.YYYY
 	if strings.Count(s, ".") == 2 {
 		parts := strings.Split(s, ".")
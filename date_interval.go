@@ -0,0 +1,137 @@
+package strtotime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseISODuration parses an ISO 8601 duration such as "P1Y2M10DT2H30M" into
+// an Interval, as PHP's DateInterval constructor does. Weeks are counted as
+// 7 days each.
+func ParseISODuration(s string) (Interval, error) {
+	p, ok := parsePeriod(s)
+	if !ok {
+		return Interval{}, fmt.Errorf("%w %q: malformed duration", ErrInvalidInterval, s)
+	}
+	return Interval{
+		Years:       p.Years,
+		Months:      p.Months,
+		Days:        7*p.Weeks + p.Days,
+		Hours:       p.Hours,
+		Minutes:     p.Minutes,
+		Seconds:     p.Seconds,
+		Nanoseconds: p.Nanoseconds,
+	}, nil
+}
+
+// IntervalFromDateString returns the relative part of a date string, such
+// as "1 day + 12 hours" or "3 months ago", as an Interval, like PHP's
+// DateInterval::createFromDateString. As in PHP, the fields keep their
+// sign, so "3 months ago" is -3 months rather than an inverted interval,
+// and any absolute date or time in the string is ignored.
+func IntervalFromDateString(s string) (Interval, error) {
+	pd := DateParse(s)
+	if err := pd.firstError(); err != nil {
+		return Interval{}, fmt.Errorf("%w %q: %w", ErrInvalidInterval, s, err)
+	}
+	var iv Interval
+	if rel := pd.Relative; rel != nil {
+		iv.Years, iv.Months, iv.Days = rel.Year, rel.Month, rel.Day
+		iv.Hours, iv.Minutes, iv.Seconds = rel.Hour, rel.Minute, rel.Second
+	}
+	return iv, nil
+}
+
+// AddTo returns t moved by the interval, backwards when Invert is set, as
+// PHP's DateTime::add does. Years and months are added first, then days,
+// then the clock units, following the MonthArithmetic and DayArithmetic
+// options.
+func (iv Interval) AddTo(t time.Time, opts ...Option) time.Time {
+	sign := 1
+	if iv.Invert {
+		sign = -1
+	}
+	p := Period{
+		Years: iv.Years, Months: iv.Months, Days: iv.Days,
+		Hours: iv.Hours, Minutes: iv.Minutes, Seconds: iv.Seconds,
+		Nanoseconds: iv.Nanoseconds,
+	}
+	cfg := resolveSettings(withDefaults(opts))
+	if cfg.months == MonthError {
+		cfg.months = MonthOverflowPHP
+	}
+	t, _ = p.shift(t, sign, cfg)
+	return t
+}
+
+// Format writes the interval following format, with the conversions of
+// PHP's DateInterval::format: %y, %m, %d, %h, %i and %s for the fields and
+// %Y, %M, %D, %H, %I and %S for the same zero-padded to two digits, %f and
+// %F for the microseconds (%F padded to six digits), %a for TotalDays or
+// "(unknown)", %R for "-" or "+" and %r for "-" or nothing depending on
+// Invert, and %% for a percent sign. Other characters are copied, as is a
+// percent sign before any other one.
+func (iv Interval) Format(format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		switch c := format[i]; c {
+		case 'y':
+			b.WriteString(strconv.Itoa(iv.Years))
+		case 'Y':
+			fmt.Fprintf(&b, "%02d", iv.Years)
+		case 'm':
+			b.WriteString(strconv.Itoa(iv.Months))
+		case 'M':
+			fmt.Fprintf(&b, "%02d", iv.Months)
+		case 'd':
+			b.WriteString(strconv.Itoa(iv.Days))
+		case 'D':
+			fmt.Fprintf(&b, "%02d", iv.Days)
+		case 'h':
+			b.WriteString(strconv.Itoa(iv.Hours))
+		case 'H':
+			fmt.Fprintf(&b, "%02d", iv.Hours)
+		case 'i':
+			b.WriteString(strconv.Itoa(iv.Minutes))
+		case 'I':
+			fmt.Fprintf(&b, "%02d", iv.Minutes)
+		case 's':
+			b.WriteString(strconv.Itoa(iv.Seconds))
+		case 'S':
+			fmt.Fprintf(&b, "%02d", iv.Seconds)
+		case 'f':
+			b.WriteString(strconv.Itoa(iv.Nanoseconds / 1000))
+		case 'F':
+			fmt.Fprintf(&b, "%06d", iv.Nanoseconds/1000)
+		case 'a':
+			if iv.TotalDays.Set {
+				b.WriteString(strconv.Itoa(iv.TotalDays.V))
+			} else {
+				b.WriteString("(unknown)")
+			}
+		case 'R':
+			if iv.Invert {
+				b.WriteByte('-')
+			} else {
+				b.WriteByte('+')
+			}
+		case 'r':
+			if iv.Invert {
+				b.WriteByte('-')
+			}
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
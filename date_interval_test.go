@@ -0,0 +1,114 @@
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Interval
+	}{
+		{"P1Y2M10DT2H30M", Interval{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}},
+		{"P2W", Interval{Days: 14}},
+		{"PT1.5S", Interval{Seconds: 1, Nanoseconds: 500000000}},
+	}
+	for _, tt := range tests {
+		got, err := ParseISODuration(tt.in)
+		if err != nil {
+			t.Errorf("ParseISODuration(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseISODuration(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "P", "1D", "PT", "P1H"} {
+		if _, err := ParseISODuration(in); !errors.Is(err, ErrInvalidInterval) {
+			t.Errorf("ParseISODuration(%q) error = %v, want ErrInvalidInterval", in, err)
+		}
+	}
+}
+
+func TestIntervalFromDateString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Interval
+	}{
+		{"1 day + 12 hours", Interval{Days: 1, Hours: 12}},
+		{"+1 week 2 days", Interval{Days: 9}},
+		{"3 months ago", Interval{Months: -3}},
+		{"1 year 30 minutes", Interval{Years: 1, Minutes: 30}},
+	}
+	for _, tt := range tests {
+		got, err := IntervalFromDateString(tt.in)
+		if err != nil {
+			t.Errorf("IntervalFromDateString(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("IntervalFromDateString(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := IntervalFromDateString("nonsense"); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("IntervalFromDateString(\"nonsense\") error = %v, want ErrInvalidInterval", err)
+	}
+}
+
+func TestIntervalAddTo(t *testing.T) {
+	base := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		iv   Interval
+		opts []Option
+		want time.Time
+	}{
+		{Interval{Months: 1}, nil, time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{Interval{Months: 1}, []Option{MonthArithmetic(MonthClamp)}, time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{Interval{Days: 1, Hours: 12}, nil, time.Date(2024, 2, 1, 22, 0, 0, 0, time.UTC)},
+		{Interval{Days: 1, Invert: true}, nil, time.Date(2024, 1, 30, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := tt.iv.AddTo(base, tt.opts...); !got.Equal(tt.want) {
+			t.Errorf("%+v.AddTo(%v) = %v, want %v", tt.iv, base, got, tt.want)
+		}
+	}
+
+	a := time.Date(2021, 1, 15, 8, 0, 0, 0, time.UTC)
+	b := time.Date(2024, 3, 20, 6, 30, 0, 0, time.UTC)
+	if got := Diff(a, b).AddTo(a); !got.Equal(b) {
+		t.Errorf("Diff(a, b).AddTo(a) = %v, want %v", got, b)
+	}
+	if got := Diff(b, a).AddTo(b); !got.Equal(a) {
+		t.Errorf("Diff(b, a).AddTo(b) = %v, want %v", got, a)
+	}
+}
+
+func TestIntervalFormat(t *testing.T) {
+	iv := Diff(time.Date(2021, 1, 15, 8, 0, 0, 0, time.UTC), time.Date(2024, 3, 20, 6, 5, 9, 250000000, time.UTC))
+	tests := []struct {
+		format, want string
+	}{
+		{"%y-%m-%d %h:%i:%s", "3-2-4 22:5:9"},
+		{"%Y-%M-%D %H:%I:%S.%F", "03-02-04 22:05:09.250000"},
+		{"%f", "250000"},
+		{"%a days", "1159 days"},
+		{"%R%d %r%d 100%% %q", "+4 4 100% %q"},
+	}
+	for _, tt := range tests {
+		if got := iv.Format(tt.format); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	iv.Invert = true
+	if got := iv.Format("%R%a %r%y"); got != "-1159 -3" {
+		t.Errorf("Format of an inverted interval = %q, want \"-1159 -3\"", got)
+	}
+	if got := (Interval{Days: 2}).Format("%a"); got != "(unknown)" {
+		t.Errorf("Format(%%a) without total days = %q, want \"(unknown)\"", got)
+	}
+}
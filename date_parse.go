@@ -1,9 +1,6 @@
 package strtotime
 
-import (
-	"strings"
-	"time"
-)
+import "time"
 
 // DateParse parses a date/time string the same way StrToTime does and returns
 // a ParsedDate describing which components were present in the input.
@@ -25,7 +22,9 @@ func DateParse(str string) *ParsedDate {
 		pd.AddError(0, "Empty string")
 		return pd
 	}
-	str = strings.ToLower(strings.TrimSpace(str))
+	// Tabs and newlines separate components like spaces, and error
+	// positions stay those of the input.
+	str = blankSpaces(normalizeInput(str))
 	if str == "" {
 		return pd
 	}
@@ -36,6 +35,11 @@ func DateParse(str string) *ParsedDate {
 	var zeroNow time.Time
 	loc := time.UTC
 
-	dispatchStrToTime(str, zeroNow, loc, nil, pd)
+	dispatchStrToTime(str, zeroNow, loc, dateParseOpts, pd)
 	return pd
 }
+
+// dateParseOpts are the options DateParse parses with. Like PHP's
+// date_parse, it reports what it could read of inputs with trailing text
+// rather than nothing, but does not skip unknown words.
+var dateParseOpts = []Option{lenientOption{}}
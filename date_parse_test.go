@@ -224,6 +224,23 @@ func TestDateParse_FieldOrder(t *testing.T) {
 	}
 }
 
+// TestDateParse_Whitespace verifies that tabs and newlines separate
+// components like spaces and keep error positions.
+func TestDateParse_Whitespace(t *testing.T) {
+	pd := DateParse("2023-01-15\t10:30:45")
+	if pd.ErrorCount != 0 || pd.Year.V != 2023 || pd.Hour.V != 10 || pd.Second.V != 45 {
+		t.Errorf("DateParse with a tab = %+v, want 2023-01-15 10:30:45", pd)
+	}
+
+	pd = DateParse("10:30\n\tzz")
+	want := DateParse("10:30  zz")
+	a, _ := json.Marshal(pd)
+	b, _ := json.Marshal(want)
+	if string(a) != string(b) {
+		t.Errorf("DateParse with a newline and a tab = %s, want %s", a, b)
+	}
+}
+
 // jsonEq compares two values decoded from JSON, treating numbers as float64
 // and any two empty collections ([]any / map[string]any) as equivalent.
 func jsonEq(a, b any) bool {
@@ -8,7 +8,8 @@ import (
 )
 
 // parseWithTimezone tries to parse dates with timezone information
-// Examples: "January 1 2023 PST", "June 1 1985 16:30:00 Europe/Paris", "2005-07-14 22:30:41 GMT"
+// Examples: "January 1 2023 PST", "June 1 1985 16:30:00 Europe/Paris", "2005-07-14 22:30:41 GMT",
+// "Jan 15 2023 10:00 -0500"
 func parseWithTimezone(str string, loc *time.Location) (time.Time, bool) {
 	// First try the full date + time + timezone format
 	if t, ok := parseFullDateTimeWithTimezone(str, loc); ok {
@@ -29,6 +30,28 @@ func parseWithTimezone(str string, loc *time.Location) (time.Time, bool) {
 	return time.Time{}, false
 }
 
+// parseZoneField reads the zone ending a date: a name such as "PST" or
+// "Europe/Paris", or a UTC offset such as "+0200", "-05:00" or "+2".
+func parseZoneField(s string) (*time.Location, bool) {
+	if s == "" {
+		return nil, false
+	}
+	if s[0] != '+' && s[0] != '-' {
+		return tryParseTimezone(s)
+	}
+	// A lone hour, "+2", which parseNumericTimezoneOffset leaves out.
+	if len(s) == 2 && isDigit(s[1]) {
+		return fixedZone(computeOffsetSeconds(s)), true
+	}
+	tz, n, ok := parseNumericTimezoneOffset(s)
+	return tz, ok && n == len(s)
+}
+
+// isZoneFieldChar reports whether c can appear in a zone name or offset.
+func isZoneFieldChar(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("/_.+-:", c)
+}
+
 // parseISODateTimeWithTimezone parses "YYYY-MM-DD HH:MM:SS timezone"
 func parseISODateTimeWithTimezone(str string, loc *time.Location) (time.Time, bool) {
 	// Need at least "Y-M-D H:M:S T" which is quite short
@@ -73,9 +96,9 @@ func parseISODateTimeWithTimezone(str string, loc *time.Location) (time.Time, bo
 		return time.Time{}, false
 	}
 
-	// Validate timezone contains only valid characters (alphanumeric, /, _, .)
+	// Validate timezone contains only valid characters (alphanumeric, /, _, ., and +, -, : for offsets)
 	for _, c := range tzString {
-		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '/' && c != '_' && c != '.' {
+		if !isZoneFieldChar(c) {
 			return time.Time{}, false
 		}
 	}
@@ -90,7 +113,7 @@ func parseISODateTimeWithTimezone(str string, loc *time.Location) (time.Time, bo
 	t = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, t.Location())
 
 	// Parse timezone
-	tzLoc, found := tryParseTimezone(tzString)
+	tzLoc, found := parseZoneField(tzString)
 	if !found {
 		return time.Time{}, false
 	}
@@ -111,10 +134,10 @@ func parseTimeOnlyWithTimezone(str string, loc *time.Location) (time.Time, bool)
 	tzString := strings.TrimSpace(str[spaceIdx+1:])
 
 	// Parse time part
-	timeParts := strings.Split(timePart, ":")
-	if len(timeParts) < 2 || len(timeParts) > 3 {
+	if n := strings.Count(timePart, ":"); n < 1 || n > 2 {
 		return time.Time{}, false
 	}
+	timeParts := strings.Split(timePart, ":")
 
 	hour, errH := strconv.Atoi(timeParts[0])
 	minute, errM := strconv.Atoi(timeParts[1])
@@ -132,13 +155,13 @@ func parseTimeOnlyWithTimezone(str string, loc *time.Location) (time.Time, bool)
 
 	// Validate timezone contains only valid characters
 	for _, c := range tzString {
-		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '/' && c != '_' && c != '.' {
+		if !isZoneFieldChar(c) {
 			return time.Time{}, false
 		}
 	}
 
 	// Parse timezone
-	tzLoc, found := tryParseTimezone(tzString)
+	tzLoc, found := parseZoneField(tzString)
 	if !found {
 		return time.Time{}, false
 	}
@@ -151,7 +174,7 @@ func parseTimeOnlyWithTimezone(str string, loc *time.Location) (time.Time, bool)
 func parseFullDateTimeWithTimezone(str string, loc *time.Location) (time.Time, bool) {
 	// Format: "MonthName Day Year [HH:MM[:SS]] Timezone"
 	// Examples: "January 1 2023 PST", "June 1 1985 16:30:00 Europe/Paris"
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 4 {
 		return time.Time{}, false
 	}
@@ -253,7 +276,7 @@ func parseFullDateTimeWithTimezone(str string, loc *time.Location) (time.Time, b
 	// or multi-word but typically one field since Fields splits on spaces)
 	tzString := strings.Join(fields[idx:], " ")
 
-	tzLoc, found := tryParseTimezone(tzString)
+	tzLoc, found := parseZoneField(tzString)
 	if !found {
 		return time.Time{}, false
 	}
@@ -0,0 +1,60 @@
+package strtotime
+
+import "strings"
+
+// swapDayFirst rewrites the numeric dates with slashes in str that are
+// written day first, "dd/mm", "dd/mm/yy" and "dd/mm/yyyy", month first as
+// PHP reads them, for the DayFirst option. The fields keep their width, so
+// the offsets of the rest of str don't move.
+func swapDayFirst(str string) string {
+	var b []byte
+	for i := 0; i < len(str); {
+		j := i
+		for j < len(str) && (isDigit(str[j]) || str[j] == '/') {
+			j++
+		}
+		if j == i {
+			i++
+			continue
+		}
+		if (i == 0 || !isDateFieldNeighbour(str[i-1])) && (j == len(str) || !isDateFieldNeighbour(str[j])) {
+			if day, month, ok := dayMonthFields(str[i:j]); ok {
+				if b == nil {
+					b = []byte(str)
+				}
+				copy(b[i:], month+"/"+day)
+			}
+		}
+		i = j
+	}
+	if b == nil {
+		return str
+	}
+	return string(b)
+}
+
+// isDateFieldNeighbour reports whether c next to a run of digits and
+// slashes makes it part of something else than a date, such as a word, a
+// time or another date.
+func isDateFieldNeighbour(c byte) bool {
+	return isDigit(c) || c == '-' || c == '.' || c == ':' || (c|0x20 >= 'a' && c|0x20 <= 'z')
+}
+
+// dayMonthFields splits the slash date s into its day and month fields when
+// it has two fields of one or two digits, optionally followed by a year of
+// two or four digits.
+func dayMonthFields(s string) (day, month string, ok bool) {
+	fields := strings.Split(s, "/")
+	if len(fields) != 2 && len(fields) != 3 {
+		return "", "", false
+	}
+	for i, f := range fields {
+		if i < 2 && (len(f) < 1 || len(f) > 2) {
+			return "", "", false
+		}
+		if i == 2 && len(f) != 2 && len(f) != 4 {
+			return "", "", false
+		}
+	}
+	return fields[0], fields[1], true
+}
@@ -0,0 +1,140 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// parseDayOfYear parses days given by their number in the year: "day 200",
+// "day 200 of 2024", "200th day of 2024" and "200th day of this year", with
+// "next year" and "last year" also accepted. Without a year the day is taken
+// in the current one. The result is midnight on that day; day 366 only
+// exists in leap years.
+func parseDayOfYear(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	var n int
+	switch {
+	case len(fields) >= 2 && fields[0] == "day" && isAllDigits(fields[1]) && len(fields[1]) <= 3:
+		n = digitsValue(fields[1])
+		fields = fields[2:]
+		if len(fields) > 0 && fields[0] != "of" && fields[0] != "in" {
+			return time.Time{}, false
+		}
+	case len(fields) >= 3 && fields[1] == "day" && fields[2] == "of":
+		var ok bool
+		if n, ok = ordinalNumber(fields[0]); !ok {
+			return time.Time{}, false
+		}
+		fields = fields[2:]
+	default:
+		return time.Time{}, false
+	}
+
+	year := now.In(loc).Year()
+	if len(fields) > 0 {
+		var ok bool
+		if year, ok = dayOfYearYear(fields[1:], year); !ok {
+			return time.Time{}, false
+		}
+	}
+
+	if n < 1 || n > daysInYear(year) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.January, n, 0, 0, 0, 0, loc), true
+}
+
+// ordinalNumber reads a number written with its ordinal suffix, as "200th"
+// or "21st", of up to three digits.
+func ordinalNumber(w string) (int, bool) {
+	i := 0
+	for i < len(w) && isDigit(w[i]) {
+		i++
+	}
+	if i == 0 || i > 3 {
+		return 0, false
+	}
+	n := digitsValue(w[:i])
+	return n, w[i:] == ordinalSuffix(n)
+}
+
+// dayOfYearYear reads the year after "of": a four-digit year, "year", or
+// "this", "next" or "last" followed by "year", the latter relative to
+// current.
+func dayOfYearYear(fields []string, current int) (int, bool) {
+	switch {
+	case len(fields) == 1 && len(fields[0]) == 4 && isAllDigits(fields[0]):
+		return digitsValue(fields[0]), true
+	case len(fields) == 1 && fields[0] == UnitYear:
+		return current, true
+	case len(fields) == 2 && fields[1] == UnitYear:
+		switch fields[0] {
+		case "this":
+			return current, true
+		case DirectionNext:
+			return current + 1, true
+		case DirectionLast:
+			return current - 1, true
+		}
+	}
+	return 0, false
+}
+
+// parseWeekdayOfYear parses weekdays given by their rank in a year: "10th
+// friday of 2024", "first monday of the year" or "last sunday of 2024".
+// Ranks go from "1st" or "first" to "53rd", and "last". The scope is the
+// whole year only with a year number or "the year": as in PHP, "first
+// monday of next year" is the first Monday of the month a year from now.
+func parseWeekdayOfYear(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if len(fields) < 4 || fields[2] != "of" {
+		return time.Time{}, false
+	}
+	n, ok := ordinalNumber(fields[0])
+	switch {
+	case fields[0] == DirectionLast:
+		n, ok = -1, true
+	case !ok:
+		n = ordinalWordToNumber(fields[0])
+		ok = n > 0
+	}
+	wd := getDayOfWeek(fields[1])
+	if !ok || n == 0 || n > 53 || wd < 0 {
+		return time.Time{}, false
+	}
+
+	var year int
+	switch rest := fields[3:]; {
+	case len(rest) == 1 && len(rest[0]) == 4 && isAllDigits(rest[0]):
+		year = digitsValue(rest[0])
+	case len(rest) == 2 && rest[0] == "the" && rest[1] == UnitYear:
+		year = now.In(loc).Year()
+	default:
+		return time.Time{}, false
+	}
+
+	if n < 0 {
+		dec31 := time.Date(year, time.December, 31, 0, 0, 0, 0, loc)
+		return dec31.AddDate(0, 0, -((int(dec31.Weekday()) - wd + 7) % 7)), true
+	}
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	t := jan1.AddDate(0, 0, (wd-int(jan1.Weekday())+7)%7+(n-1)*7)
+	return t, t.Year() == year
+}
+
+// daysInYear returns the number of days in year, 365 or 366.
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func parseDayOfYearInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseDayOfYear(str, now, loc)
+	if !ok {
+		if t, ok = parseWeekdayOfYear(str, now, loc); !ok {
+			return false
+		}
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(0, 0, 0)
+	pd.setMaterialized(t)
+	return true
+}
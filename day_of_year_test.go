@@ -0,0 +1,50 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayOfYear(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"day 200 of 2024":           time.Date(2024, 7, 18, 0, 0, 0, 0, time.UTC),
+		"day 200 of 2023":           time.Date(2023, 7, 19, 0, 0, 0, 0, time.UTC),
+		"day 1 in 2020":             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		"day 366 of 2024":           time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		"day 60":                    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		"200th day of the year":     time.Date(2024, 7, 18, 0, 0, 0, 0, time.UTC),
+		"201st day of 2024":         time.Date(2024, 7, 19, 0, 0, 0, 0, time.UTC),
+		"the 60th day of next year": time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		"32nd day of last year":     time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		"10th friday of 2024":       time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+		"first monday of 2025":      time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		"first monday of the year":  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"last sunday of the year":   time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC),
+		"last tuesday of 2024":      time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		"53rd sunday of 2023":       time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{
+		"day 0",
+		"day 366 of 2023",
+		"201th day of 2024",
+		"day 200 of march",
+		"day 1000",
+		"53rd friday of 2024",
+		"0th friday of 2024",
+		"54th sunday of 2023",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
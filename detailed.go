@@ -0,0 +1,81 @@
+package strtotime
+
+import "time"
+
+// Field is a set of the components of a date and time, as reported by
+// ParseDetailed.
+type Field uint
+
+// The components an input may specify.
+const (
+	FieldYear Field = 1 << iota
+	FieldMonth
+	FieldDay
+	FieldHour
+	FieldMinute
+	FieldSecond
+	FieldFraction
+	FieldZone
+	FieldRelative
+
+	// FieldDate and FieldTime are the components of a full date and of a
+	// time to the second.
+	FieldDate = FieldYear | FieldMonth | FieldDay
+	FieldTime = FieldHour | FieldMinute | FieldSecond
+)
+
+// DetailedResult is the outcome of ParseDetailed.
+type DetailedResult struct {
+	Time time.Time
+	// Fields holds the components the input specified; the others were
+	// taken from the reference time or set to midnight, as StrToTime does.
+	// They are the fields DateParse reports, except for the day PHP fills
+	// in for a month and year ("January 2024"): a time such as "10am" sets
+	// the hour, minute and second, and a relative expression such as
+	// "tomorrow" is a FieldRelative, not a day.
+	Fields Field
+	// Format names the format or pipeline stage that matched, as in
+	// ParseEvent.
+	Format string
+}
+
+// Has reports whether the input specified all of the components of f.
+func (r DetailedResult) Has(f Field) bool {
+	return r.Fields&f == f
+}
+
+// ParseDetailed parses str as StrToTime does and also reports which
+// components the input specified, so that "January 2024" can be told from
+// "January 15 2024 10:30", along with the format that matched.
+func ParseDetailed(str string, opts ...Option) (DetailedResult, error) {
+	pd := newParsedDate()
+	t, err := strToTime(str, withDefaults(opts), pd)
+	if err != nil {
+		return DetailedResult{}, err
+	}
+	return DetailedResult{Time: t, Fields: pd.fields(), Format: pd.format}, nil
+}
+
+// fields returns the components set in pd.
+func (pd *ParsedDate) fields() Field {
+	var f Field
+	for _, c := range []struct {
+		set   bool
+		field Field
+	}{
+		{pd.Year.Set, FieldYear},
+		{pd.Month.Set, FieldMonth},
+		{pd.Day.Set && !pd.dayDefaulted, FieldDay},
+		{pd.Hour.Set, FieldHour},
+		{pd.Minute.Set, FieldMinute},
+		{pd.Second.Set, FieldSecond},
+		{pd.Fraction.Set && pd.Fraction.V != 0, FieldFraction},
+		{pd.ZoneType != 0, FieldZone},
+		{pd.Relative != nil, FieldRelative},
+	} {
+		if c.set {
+			f |= c.field
+		}
+	}
+	return f
+}
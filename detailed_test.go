@@ -0,0 +1,50 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDetailed(t *testing.T) {
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		input  string
+		fields Field
+		format string
+	}{
+		{"January 2024", FieldYear | FieldMonth, "month_year"},
+		{"2024-01", FieldYear | FieldMonth, "year_month"},
+		{"Jan 2024 10:00", FieldYear | FieldMonth | FieldTime, "month_year"},
+		{"January 15 2024 10:30", FieldDate | FieldTime, "grammar"},
+		{"Jan 15", FieldMonth | FieldDay, "grammar"},
+		{"January", FieldMonth, "grammar"},
+		{"2024-01-15T10:30:45.5Z", FieldDate | FieldTime | FieldFraction | FieldZone, "iso8601"},
+		{"2023-01-15 10:00 +0200", FieldDate | FieldTime | FieldZone, "date_time"},
+		{"tomorrow", FieldTime | FieldRelative, "keyword"},
+		{"1 hour ago", FieldRelative, "grammar"},
+		{"now", 0, "keyword"},
+	}
+	for _, tt := range tests {
+		r, err := ParseDetailed(tt.input, base)
+		if err != nil {
+			t.Errorf("ParseDetailed(%q) error: %v", tt.input, err)
+			continue
+		}
+		if r.Fields != tt.fields || r.Format != tt.format {
+			t.Errorf("ParseDetailed(%q) = %09b %q, want %09b %q", tt.input, r.Fields, r.Format, tt.fields, tt.format)
+		}
+		if want, _ := StrToTime(tt.input, base); !r.Time.Equal(want) {
+			t.Errorf("ParseDetailed(%q).Time = %v, want %v", tt.input, r.Time, want)
+		}
+	}
+
+	r, _ := ParseDetailed("January 15 2024", base)
+	if !r.Has(FieldDate) || r.Has(FieldHour) || r.Has(FieldDate|FieldHour) {
+		t.Errorf("ParseDetailed(\"January 15 2024\").Has gives the wrong answers for %09b", r.Fields)
+	}
+
+	if _, err := ParseDetailed("garbage", base); err == nil {
+		t.Error("ParseDetailed(\"garbage\") should fail")
+	}
+}
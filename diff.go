@@ -0,0 +1,143 @@
+package strtotime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is an amount of time broken down into years, months, days and
+// clock units, like PHP's DateInterval: Years, Months, Days, Hours, Minutes
+// and Seconds are its y, m, d, h, i and s, Nanoseconds gives f, and Invert
+// and TotalDays are invert and days. Intervals come from Diff,
+// ParseISODuration and IntervalFromDateString.
+type Interval struct {
+	Years, Months, Days     int
+	Hours, Minutes, Seconds int
+	Nanoseconds             int
+
+	// Invert is set when the interval runs backward in time: the second
+	// time given to Diff is before the first one.
+	Invert bool
+
+	// TotalDays is the number of whole days between the two times given
+	// to Diff. It is unset, as PHP's days is false, in intervals that
+	// don't come from Diff.
+	TotalDays OptInt
+}
+
+// Diff returns the difference from a to b. Whole years and months come
+// first, counted as calendar months that can be added to a without passing
+// b, a day missing from a shorter month counting as that month's last day
+// (January 31st to March 1st is 1 month and 1 day). The remaining time is
+// broken into days and clock units, with days counted on the calendar so
+// that a DST change doesn't make a day of 23 or 25 hours.
+//
+// The units are read in the location of a. When b comes before a, the
+// difference is computed from b to a and Invert is set.
+func Diff(a, b time.Time) Interval {
+	b = b.In(a.Location())
+	var iv Interval
+	if b.Before(a) {
+		a, b = b, a
+		iv.Invert = true
+	}
+
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	months := (by-ay)*12 + int(bm-am)
+	mid := addMonths(a, months, MonthClamp)
+	for months > 0 && mid.After(b) {
+		months--
+		mid = addMonths(a, months, MonthClamp)
+	}
+	iv.Years, iv.Months = months/12, months%12
+
+	days := int(b.Sub(mid) / (24 * time.Hour))
+	for days > 0 && mid.AddDate(0, 0, days).After(b) {
+		days--
+	}
+	for !mid.AddDate(0, 0, days+1).After(b) {
+		days++
+	}
+	iv.Days = days
+
+	iv.TotalDays = OptInt{V: wholeDays(a, b), Set: true}
+
+	rest := b.Sub(mid.AddDate(0, 0, days))
+	iv.Hours = int(rest / time.Hour)
+	iv.Minutes = int(rest % time.Hour / time.Minute)
+	iv.Seconds = int(rest % time.Minute / time.Second)
+	iv.Nanoseconds = int(rest % time.Second)
+	return iv
+}
+
+// wholeDays returns the number of whole days from a to b, on the calendar
+// of the location of a: the days between their dates, less one when the
+// time of day of b is earlier than that of a.
+func wholeDays(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	days := int(phpEpochDays(int64(by), int64(bm), int64(bd)) - phpEpochDays(int64(ay), int64(am), int64(ad)))
+	if clockOf(b) < clockOf(a) {
+		days--
+	}
+	return days
+}
+
+// clockOf returns the time of day of t as a duration since midnight.
+func clockOf(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+}
+
+// Age returns the age in whole years, on asOf, of someone born on birth.
+// People born on February 29th turn a year older on February 28th in
+// common years. Ages before birth are negative.
+func Age(birth, asOf time.Time) int {
+	iv := Diff(birth, asOf)
+	if iv.Invert {
+		return -iv.Years
+	}
+	return iv.Years
+}
+
+// String returns the interval in words, from years down to seconds and
+// leaving out the units that are zero: "3 years, 2 months, 5 days". The
+// direction is not written; see Invert.
+func (iv Interval) String() string {
+	return iv.Humanize(0)
+}
+
+// Humanize is like String, but writes at most maxUnits units, starting
+// with the largest one that isn't zero: with 2, an interval of 3 years, 2
+// months and 5 days is written "3 years, 2 months". The last unit is not
+// rounded. A maxUnits of zero or less writes every unit.
+func (iv Interval) Humanize(maxUnits int) string {
+	parts := make([]string, 0, 6)
+	for _, u := range []struct {
+		n    int
+		name string
+	}{
+		{iv.Years, UnitYear},
+		{iv.Months, UnitMonth},
+		{iv.Days, UnitDay},
+		{iv.Hours, UnitHour},
+		{iv.Minutes, UnitMinute},
+		{iv.Seconds, UnitSecond},
+	} {
+		if u.n == 0 || (maxUnits > 0 && len(parts) == maxUnits) {
+			continue
+		}
+		s := strconv.Itoa(u.n) + " " + u.name
+		if u.n != 1 {
+			s += "s"
+		}
+		parts = append(parts, s)
+	}
+	if len(parts) == 0 {
+		return "0 " + UnitSecond + "s"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,64 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	date := func(y int, m time.Month, d, h, mi, s int) time.Time {
+		return time.Date(y, m, d, h, mi, s, 0, time.UTC)
+	}
+	tests := []struct {
+		a, b time.Time
+		want Interval
+		text string
+	}{
+		{date(2021, 1, 15, 0, 0, 0), date(2024, 3, 20, 0, 0, 0), Interval{Years: 3, Months: 2, Days: 5, TotalDays: OptInt{V: 1160, Set: true}}, "3 years, 2 months, 5 days"},
+		{date(2023, 1, 31, 0, 0, 0), date(2023, 3, 1, 0, 0, 0), Interval{Months: 1, Days: 1, TotalDays: OptInt{V: 29, Set: true}}, "1 month, 1 day"},
+		{date(2024, 1, 31, 0, 0, 0), date(2024, 2, 29, 0, 0, 0), Interval{Months: 1, TotalDays: OptInt{V: 29, Set: true}}, "1 month"},
+		{date(2024, 3, 1, 10, 0, 0), date(2024, 3, 1, 12, 30, 15), Interval{Hours: 2, Minutes: 30, Seconds: 15, TotalDays: OptInt{V: 0, Set: true}}, "2 hours, 30 minutes, 15 seconds"},
+		{date(2024, 3, 1, 10, 0, 0), date(2024, 3, 3, 9, 0, 0), Interval{Days: 1, Hours: 23, TotalDays: OptInt{V: 1, Set: true}}, "1 day, 23 hours"},
+		{date(2024, 3, 1, 23, 0, 0), date(2024, 3, 2, 1, 0, 0), Interval{Hours: 2, TotalDays: OptInt{V: 0, Set: true}}, "2 hours"},
+		{date(2024, 3, 20, 0, 0, 0), date(2021, 1, 15, 0, 0, 0), Interval{Years: 3, Months: 2, Days: 5, Invert: true, TotalDays: OptInt{V: 1160, Set: true}}, "3 years, 2 months, 5 days"},
+		{date(2024, 3, 1, 0, 0, 0), date(2024, 3, 1, 0, 0, 0), Interval{TotalDays: OptInt{V: 0, Set: true}}, "0 seconds"},
+	}
+	for _, tt := range tests {
+		got := Diff(tt.a, tt.b)
+		if got != tt.want {
+			t.Errorf("Diff(%v, %v) = %+v, want %+v", tt.a, tt.b, got, tt.want)
+		}
+		if s := got.String(); s != tt.text {
+			t.Errorf("Diff(%v, %v).String() = %q, want %q", tt.a, tt.b, s, tt.text)
+		}
+	}
+
+	if got := Diff(date(2021, 1, 15, 0, 0, 0), date(2024, 3, 20, 6, 0, 0)).Humanize(2); got != "3 years, 2 months" {
+		t.Errorf("Humanize(2) = %q, want \"3 years, 2 months\"", got)
+	}
+
+	// A day across the start of DST is one day, not 23 hours.
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("Europe/Paris not available")
+	}
+	got := Diff(time.Date(2024, 3, 30, 12, 0, 0, 0, paris), time.Date(2024, 3, 31, 12, 0, 0, 0, paris))
+	if got != (Interval{Days: 1, TotalDays: OptInt{V: 1, Set: true}}) {
+		t.Errorf("Diff across DST = %+v, want 1 day", got)
+	}
+}
+
+func TestAge(t *testing.T) {
+	birth := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	for asOf, want := range map[time.Time]int{
+		time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC): 23,
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC): 24,
+		time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC): 23,
+		time.Date(2023, 2, 27, 0, 0, 0, 0, time.UTC): 22,
+		time.Date(2000, 3, 1, 0, 0, 0, 0, time.UTC):  0,
+	} {
+		if got := Age(birth, asOf); got != want {
+			t.Errorf("Age(%v, %v) = %d, want %d", birth, asOf, got, want)
+		}
+	}
+}
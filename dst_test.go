@@ -0,0 +1,57 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+// TestDayArithmeticDST pins day offsets to daylight saving time changes.
+// Calendar days keep the wall clock time, elapsed days move by 24 hours.
+func TestDayArithmeticDST(t *testing.T) {
+	tests := []struct {
+		zone     string
+		base     string // wall clock time in zone
+		input    string
+		calendar string // expected wall clock times in zone
+		elapsed  string
+	}{
+		// America/New_York springs forward on 2024-03-10 and falls back on 2024-11-03.
+		{"America/New_York", "2024-03-09 12:00", "tomorrow", "2024-03-10 00:00", "2024-03-10 00:00"},
+		{"America/New_York", "2024-03-09 12:00", "+1 day", "2024-03-10 12:00", "2024-03-10 13:00"},
+		{"America/New_York", "2024-03-09 12:00", "next day", "2024-03-10 12:00", "2024-03-10 13:00"},
+		{"America/New_York", "2024-03-09 12:00", "+1 week", "2024-03-16 12:00", "2024-03-16 13:00"},
+		{"America/New_York", "2024-03-10 12:00", "yesterday", "2024-03-09 00:00", "2024-03-09 00:00"},
+		{"America/New_York", "2024-03-10 12:00", "-1 day", "2024-03-09 12:00", "2024-03-09 11:00"},
+		{"America/New_York", "2024-11-02 12:00", "+1 day", "2024-11-03 12:00", "2024-11-03 11:00"},
+		{"America/New_York", "2024-11-02 12:00", "tomorrow noon", "2024-11-03 12:00", "2024-11-03 12:00"},
+		// Europe/London springs forward on 2024-03-31.
+		{"Europe/London", "2024-03-30 12:00", "+1 day", "2024-03-31 12:00", "2024-03-31 13:00"},
+		{"Europe/London", "2024-03-30 23:30", "tomorrow", "2024-03-31 00:00", "2024-04-01 00:00"},
+		// America/Sao_Paulo skipped midnight on 2018-11-04: that day starts at 01:00.
+		{"America/Sao_Paulo", "2018-11-03 12:00", "tomorrow", "2018-11-04 01:00", "2018-11-04 01:00"},
+		{"America/Sao_Paulo", "2018-11-04 12:00", "today", "2018-11-04 01:00", "2018-11-04 01:00"},
+		// Australia/Lord_Howe moves its clocks by 30 minutes, forward on 2024-10-06.
+		{"Australia/Lord_Howe", "2024-10-05 12:00", "+1 day", "2024-10-06 12:00", "2024-10-06 12:30"},
+	}
+	for _, tt := range tests {
+		loc, err := time.LoadLocation(tt.zone)
+		if err != nil {
+			t.Skipf("zone %s unavailable: %v", tt.zone, err)
+		}
+		base, _ := time.ParseInLocation("2006-01-02 15:04", tt.base, loc)
+		for _, c := range []struct {
+			policy DayPolicy
+			want   string
+		}{{CalendarDays, tt.calendar}, {ElapsedDays, tt.elapsed}} {
+			want, _ := time.ParseInLocation("2006-01-02 15:04", c.want, loc)
+			got, err := StrToTime(tt.input, Rel(base), InTZ(loc), DayArithmetic(c.policy))
+			if err != nil {
+				t.Errorf("%s %s %q (policy %d) error: %v", tt.zone, tt.base, tt.input, c.policy, err)
+				continue
+			}
+			if !got.Equal(want) {
+				t.Errorf("%s %s %q (policy %d) = %v, want %v", tt.zone, tt.base, tt.input, c.policy, got, want)
+			}
+		}
+	}
+}
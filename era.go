@@ -0,0 +1,65 @@
+package strtotime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// eraSuffixes maps the era designators recognized by the Eras option to
+// whether they count years before the common era.
+var eraSuffixes = map[string]bool{
+	"bc": true, "b.c.": true, "bce": true, "b.c.e.": true,
+	"ad": false, "a.d.": false, "ce": false, "c.e.": false,
+}
+
+// splitEra splits an input ending in "<year> <era>", such as "march 15, 44
+// bc", into the input up to the year, the year itself and whether the era
+// is before the common era.
+func splitEra(str string) (head string, year int, bce bool, ok bool) {
+	sp := strings.LastIndexByte(str, ' ')
+	if sp < 0 {
+		return "", 0, false, false
+	}
+	bce, ok = eraSuffixes[str[sp+1:]]
+	if !ok {
+		return "", 0, false, false
+	}
+	head = str[:sp]
+	i := len(head)
+	for i > 0 && isDigit(head[i-1]) {
+		i--
+	}
+	if i == len(head) || len(head)-i > maxDigitField || (i > 0 && head[i-1] != ' ') {
+		return "", 0, false, false
+	}
+	return head[:i], digitsValue(head[i:]), bce, true
+}
+
+// parseEra parses an input whose year carries an era designator. Go's
+// calendar numbers years astronomically, so 1 BC is year 0 and 44 BC is
+// year -43.
+//
+// The rest of the pipeline only reads four-digit years, and maps shorter ones
+// to the current century. The year is therefore replaced with one of 2000 to
+// 2399 sharing its place in the 400-year Gregorian cycle, which has the same
+// leap years and weekdays, and the result is shifted back afterwards.
+func parseEra(head string, year int, bce bool, opts []Option, pd *ParsedDate) (time.Time, error) {
+	if year < 1 {
+		return time.Time{}, fmt.Errorf("%w: year 0 of an era", ErrInvalidDateComponent)
+	}
+	if head == "" {
+		// A year on its own is the start of that year.
+		head = "january 1 "
+	}
+	if bce {
+		year = 1 - year
+	}
+	stand := 2000 + (year%400+400)%400
+	t, err := parseToTime(head+strconv.Itoa(stand), opts, pd)
+	if err != nil {
+		return t, err
+	}
+	return t.AddDate(year-stand, 0, 0), nil
+}
@@ -0,0 +1,44 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEras(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	opts := []Option{Rel(base), InTZ(time.UTC), Eras()}
+
+	tests := map[string]time.Time{
+		"march 15, 44 bc":       time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC),
+		"15 March 44 BC":        time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC),
+		"44 B.C.":               time.Date(-43, 1, 1, 0, 0, 0, 0, time.UTC),
+		"Feb 29 1 BCE":          time.Date(0, 2, 29, 0, 0, 0, 0, time.UTC),
+		"August 19, 14 AD":      time.Date(14, 8, 19, 0, 0, 0, 0, time.UTC),
+		"october 14 1066 ce":    time.Date(1066, 10, 14, 0, 0, 0, 0, time.UTC),
+		"December 25 800 A.D.":  time.Date(800, 12, 25, 0, 0, 0, 0, time.UTC),
+		"-0044-03-15":           time.Date(-44, 3, 15, 0, 0, 0, 0, time.UTC),
+		"2024-01-15 10:00":      time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		"friday march 15 44 bc": time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, opts...)
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"0 bc", "Feb 29 2 BC", "march 15, 44 bc"} {
+		o := opts
+		if input == "march 15, 44 bc" {
+			o = []Option{Rel(base)}
+		}
+		if _, err := StrToTime(input, o...); err == nil {
+			t.Errorf("StrToTime(%q) should fail", input)
+		}
+	}
+}
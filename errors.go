@@ -16,7 +16,17 @@ var (
 	ErrInvalidTimeComponent = errors.New("invalid time component")
 	ErrInvalidDateComponent = errors.New("invalid date component")
 	ErrInvalidDateFormat    = errors.New("invalid date format")
+	ErrInvalidDate          = errors.New("invalid date")
+	ErrOutOfRange           = errors.New("time out of range")
 	ErrInvalidTimezone      = errors.New("invalid timezone")
+	ErrTrailingText         = errors.New("unparsed trailing text")
+	ErrDoubleSpecification  = errors.New("double specification")
+	ErrInvalidInterval      = errors.New("invalid interval")
+	ErrInvalidSchedule      = errors.New("invalid schedule")
+	ErrInvalidSyslog        = errors.New("invalid syslog timestamp")
+	ErrInvalidLayout        = errors.New("invalid layout")
+	ErrStrict               = errors.New("rejected in strict mode")
+	ErrUnexpectedToken      = errors.New("unexpected token")
 )
 
 // NewInvalidTimeError returns a formatted error for invalid time components
@@ -24,15 +34,94 @@ func NewInvalidTimeError(hour, minute, second int) error {
 	return fmt.Errorf("%w: %02d:%02d:%02d", ErrInvalidTimeComponent, hour, minute, second)
 }
 
-// NewInvalidDateError returns a formatted error for invalid date components
+// newTrailingTextError returns an error wrapping ErrTrailingText that quotes
+// the text left over after the part of the input that was understood.
+func newTrailingTextError(text string) error {
+	return fmt.Errorf("%w %q", ErrTrailingText, text)
+}
+
+// ParseError reports an input StrToTime could not make sense of, with the
+// part of it that could not be read, so that it can be highlighted. Input is
+// the input as parsed, lowercased and with its whitespace collapsed, and
+// Pos the byte offset of Token in it. When nothing more precise is known,
+// Token is the whole input and Err is nil; otherwise Err says what went
+// wrong and matches a sentinel such as ErrUnexpectedToken, ErrTrailingText
+// or ErrInvalidDate with errors.Is.
+type ParseError struct {
+	Input string
+	Token string
+	Pos   int
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	if e.Err == nil {
+		return "unable to parse time string: " + e.Input
+	}
+	return fmt.Sprintf("unable to parse time string: %s: %v", e.Input, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newParseError returns a *ParseError for input that failed with err, or
+// with the text at pos not being understood when token is set.
+func newParseError(input, token string, pos int, err error) *ParseError {
+	if token == "" {
+		token = input
+	}
+	return &ParseError{Input: input, Token: token, Pos: pos, Err: err}
+}
+
+// ConflictError reports two parts of an input that specify the same thing,
+// such as the two dates of "2023-01-15 2024-02-02", the two times of "10:00
+// 11:00" or the repeated unit of "next month month". Positions are byte
+// offsets into the input after whitespace normalization. It matches
+// ErrDoubleSpecification with errors.Is.
+type ConflictError struct {
+	What                string // "date", "time" or the repeated unit
+	First, Second       string
+	FirstPos, SecondPos int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: %s %q at position %d conflicts with %q at position %d",
+		ErrDoubleSpecification, e.What, e.Second, e.SecondPos, e.First, e.FirstPos)
+}
+
+// Is reports whether target is ErrDoubleSpecification.
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrDoubleSpecification
+}
+
+// InvalidDateError reports a date that is well formed but doesn't exist in
+// the calendar, such as "February 29, 2023" or "April 31". It matches both
+// ErrInvalidDate and ErrInvalidDateComponent with errors.Is.
+type InvalidDateError struct {
+	Year, Month, Day int
+}
+
+func (e *InvalidDateError) Error() string {
+	return fmt.Sprintf("%s: %04d-%02d-%02d", ErrInvalidDate, e.Year, e.Month, e.Day)
+}
+
+// Is reports whether target is ErrInvalidDate or ErrInvalidDateComponent.
+func (e *InvalidDateError) Is(target error) bool {
+	return target == ErrInvalidDate || target == ErrInvalidDateComponent
+}
+
+// NewInvalidDateError returns an *InvalidDateError for the given components
 func NewInvalidDateError(year, month, day int) error {
-	return fmt.Errorf("%w: %04d-%02d-%02d", ErrInvalidDateComponent, year, month, day)
+	return &InvalidDateError{Year: year, Month: month, Day: day}
 }
 
-// IsValidDate checks if the date components form a valid date
+// IsValidDate checks if the date components form a valid date. Year 0 is
+// valid, as the year before year 1; StrToTime only accepts it in its input
+// with the YearZero option.
 func IsValidDate(year, month, day int) bool {
 	// Basic validation
-	if year < 1 || month < 1 || month > 12 || day < 1 {
+	if year < 0 || month < 1 || month > 12 || day < 1 {
 		return false
 	}
 
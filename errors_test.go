@@ -0,0 +1,116 @@
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestInvalidDateError(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  InvalidDateError
+	}{
+		{"February 29, 2023", InvalidDateError{2023, 2, 29}},
+		{"April 31", InvalidDateError{2024, 4, 31}},
+		{"june 31 2025", InvalidDateError{2025, 6, 31}},
+	}
+	for _, tt := range tests {
+		_, err := StrToTime(tt.input, Rel(base))
+		if !errors.Is(err, ErrInvalidDate) || !errors.Is(err, ErrInvalidDateComponent) {
+			t.Errorf("StrToTime(%q) error = %v, want ErrInvalidDate", tt.input, err)
+			continue
+		}
+		var de *InvalidDateError
+		if !errors.As(err, &de) || *de != tt.want {
+			t.Errorf("StrToTime(%q) error = %#v, want %#v", tt.input, de, tt.want)
+		}
+	}
+
+	if got, err := StrToTime("june 31 2025", Rel(base), Rollover()); err != nil || !got.Equal(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StrToTime(\"june 31 2025\") with Rollover = %v, %v", got, err)
+	}
+
+	for _, input := range []string{"February 29, 2024", "April 30"} {
+		if _, err := StrToTime(input, Rel(base)); err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+		}
+	}
+	for _, input := range []string{"hello world", "2024-01-15 bogus"} {
+		if _, err := StrToTime(input, Rel(base)); err == nil || errors.Is(err, ErrInvalidDate) {
+			t.Errorf("StrToTime(%q) error = %v, want a non-date error", input, err)
+		}
+	}
+}
+
+func TestConflictError(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  ConflictError
+	}{
+		{"10:00 11:00", ConflictError{"time", "10:00", "11:00", 0, 6}},
+		{"2023-01-15 2024-02-02", ConflictError{"date", "2023-01-15", "2024-02-02", 0, 11}},
+		{"2023-01-15 10:00 11:00", ConflictError{"time", "10:00", "11:00", 11, 17}},
+		{"jan 5 feb 6", ConflictError{"date", "jan 5", "feb 6", 0, 6}},
+		{"next month month", ConflictError{"month", "next month", "month", 0, 11}},
+	}
+	for _, tt := range tests {
+		_, err := StrToTime(tt.input, Rel(base))
+		if !errors.Is(err, ErrDoubleSpecification) {
+			t.Errorf("StrToTime(%q) error = %v, want ErrDoubleSpecification", tt.input, err)
+			continue
+		}
+		var ce *ConflictError
+		if !errors.As(err, &ce) || *ce != tt.want {
+			t.Errorf("StrToTime(%q) error = %#v, want %#v", tt.input, ce, tt.want)
+		}
+	}
+
+	// A second zone is a warning, as in PHP: the first one applies.
+	got, err := StrToTime("2024-01-15 10:00 utc est", Rel(base))
+	if want := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("StrToTime(\"2024-01-15 10:00 utc est\") = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input, token string
+		pos          int
+		sentinel     error
+	}{
+		{"Next Friday FOO", "foo", 12, ErrUnexpectedToken},
+		{"tomorrow %", "%", 9, ErrUnexpectedToken},
+		{"10:00 @@", "@@", 6, ErrUnexpectedToken},
+		{"11 oct 2005 zzz", "zzz", 12, ErrTrailingText},
+		{"10:00 11:00", "11:00", 6, ErrDoubleSpecification},
+		{"February 29, 2023", "february 29, 2023", 0, ErrInvalidDate},
+		{"next business", "next business", 0, ErrStrict},
+	}
+	for _, tt := range tests {
+		_, err := StrToTime(tt.input, Rel(base), Strict())
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("StrToTime(%q) error = %v, want a *ParseError", tt.input, err)
+			continue
+		}
+		if pe.Token != tt.token || pe.Pos != tt.pos || !errors.Is(err, tt.sentinel) {
+			t.Errorf("StrToTime(%q) error = %+v, want %q at %d matching %v", tt.input, pe, tt.token, tt.pos, tt.sentinel)
+		}
+		if pe.Input[pe.Pos:pe.Pos+len(pe.Token)] != pe.Token {
+			t.Errorf("StrToTime(%q): %q is not at position %d of %q", tt.input, pe.Token, pe.Pos, pe.Input)
+		}
+	}
+
+	_, err := StrToTime("hello world", Rel(base))
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Token != "hello world" || pe.Err != nil || err.Error() != "unable to parse time string: hello world" {
+		t.Errorf("StrToTime(\"hello world\") error = %#v", err)
+	}
+}
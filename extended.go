@@ -0,0 +1,97 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// This file and the ones sharing its build constraint hold the features that
+// go beyond PHP's strtotime grammar: log-specific timestamp formats and the
+// LineParser. Building with the strtotime_minimal tag leaves them out, for
+// size-constrained targets such as TinyGo or WebAssembly.
+
+// extendedFormatParsers are tried after the PHP formats and before the
+// fallbacks. Formats that PHP does not understand are registered here.
+var extendedFormatParsers = []formatParser{
+	{firstAlpha, "weekday_coincidence", parseWeekdayCoincidenceInto},
+	{firstAlpha, "week_reference", parseWeekReferenceInto},
+	{firstDigit, "oracle_timestamp", parseOracleTimestampInto},
+	{firstDigit | firstAlpha, "mssql_timestamp", parseMSSQLTimestampInto},
+	{firstOther, "bracketed_log", parseBracketedLogTimeInto},
+	{firstAlpha, "period_boundary", parsePeriodBoundaryInto},
+	{firstDigit | firstAlpha, "day_of_year", parseDayOfYearInto},
+	{firstAlpha, "holiday", parseHolidayInto},
+	{firstDigit | firstAlpha, "calendar_date", parseCalendarDateInto},
+	{firstDigit | firstAlpha | firstSign, "business_days", parseBusinessDaysInto},
+	{firstAlpha, "fiscal", parseFiscalInto},
+	{firstAlpha, "in_relative", parseInRelativeInto},
+	{firstDigit | firstAlpha, "rfc2822", parseRFC2822Into},
+	{firstAlpha, "asctime", parseAsctimeInto},
+	{firstDigit, "postgres_date", parsePostgresDateInto},
+	{firstAlpha, "postgres_literal", parsePostgresLiteralInto},
+}
+
+// extendedCacheKey returns the part of the Cache key for the options of
+// extended builds that change results, or false when opts hold one the key
+// cannot represent, so that the input is parsed without the cache.
+func extendedCacheKey(opts []Option) (string, bool) {
+	var b strings.Builder
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case weekendOption:
+			fmt.Fprintf(&b, "weekend %b;", o.days)
+		case businessHolidaysOption:
+			b.WriteString("holidays")
+			for _, cal := range o.cals {
+				id, ok := identityKey(cal)
+				if !ok {
+					return "", false
+				}
+				b.WriteString(" " + id)
+			}
+			b.WriteString(";")
+		case localeOption:
+			id, ok := identityKey(o.locale)
+			if !ok {
+				return "", false
+			}
+			b.WriteString("locale " + id + ";")
+		case calendarOption:
+			id, ok := identityKey(o.cal)
+			if !ok {
+				return "", false
+			}
+			b.WriteString("calendar " + id + ";")
+		}
+	}
+	if m := fiscalYearStart(opts); m != time.January {
+		fmt.Fprintf(&b, "fiscal %d;", m)
+	}
+	return b.String(), true
+}
+
+// identityKey returns a string identifying v, a value passed to an option
+// such as a holiday calendar: its type and the address of its data for
+// maps, slices, pointers and funcs, or its type and value for other
+// comparable values. Values that are modified in place after being passed
+// to an option are not told apart. It returns false for values it can't
+// identify, such as structs holding slices.
+func identityKey(v any) (string, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return "nil", true
+	case reflect.Map, reflect.Pointer, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return fmt.Sprintf("%T@%x", v, rv.Pointer()), true
+	case reflect.Slice:
+		return fmt.Sprintf("%T@%x/%d", v, rv.Pointer(), rv.Len()), true
+	}
+	if !rv.Comparable() {
+		return "", false
+	}
+	return fmt.Sprintf("%T%#v", v, v), true
+}
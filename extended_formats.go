@@ -17,6 +17,46 @@ func isAllDigits(s string) bool {
 	return len(s) > 0
 }
 
+// digitsValue returns the value of s, which must consist of ASCII digits only
+// (callers check with isAllDigits or scan the run themselves). It replaces
+// strconv.Atoi for the short fixed-width fields of compact and ISO formats.
+func digitsValue(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
+
+// maxDigitField is the longest digit run splitDigitFields accepts, so that
+// digitsValue can never overflow.
+const maxDigitField = 18
+
+// splitDigitFields splits str into exactly three non-empty digit fields
+// separated by sep, without allocating.
+func splitDigitFields(str string, sep byte) (a, b, c string, ok bool) {
+	var fields [3]string
+	n, start := 0, 0
+	for i := 0; i <= len(str); i++ {
+		if i < len(str) && str[i] != sep {
+			if str[i] < '0' || str[i] > '9' {
+				return "", "", "", false
+			}
+			continue
+		}
+		if n == 3 || i == start || i-start > maxDigitField {
+			return "", "", "", false
+		}
+		fields[n] = str[start:i]
+		n++
+		start = i + 1
+	}
+	if n != 3 {
+		return "", "", "", false
+	}
+	return fields[0], fields[1], fields[2], true
+}
+
 // isAlpha checks if a string contains only ASCII letters
 func isAlpha(s string) bool {
 	for _, c := range s {
@@ -31,72 +71,83 @@ func isAlpha(s string) bool {
 // - "19970523091528" (YYYYMMDDhhmmss, exactly 14 digits)
 // - "20050620091407 GMT" (14 digits + timezone)
 // - "20101212" (YYYYMMDD, exactly 8 digits)
-func parseCompactTimestamp(str string, loc *time.Location) (time.Time, bool) {
+//
+// It also returns the text after them, which it leaves unread.
+func parseCompactTimestamp(str string, loc *time.Location) (time.Time, string, bool) {
 	// Split on space to handle optional timezone suffix
-	parts := strings.SplitN(str, " ", 2)
-	digits := parts[0]
+	digits, rest, _ := strings.Cut(str, " ")
+	rest = strings.TrimSpace(rest)
 
 	// 8-digit YYYYMMDD format
 	if len(digits) == 8 && isAllDigits(digits) {
-		year, _ := strconv.Atoi(digits[0:4])
-		month, _ := strconv.Atoi(digits[4:6])
-		day, _ := strconv.Atoi(digits[6:8])
+		year := digitsValue(digits[0:4])
+		month := digitsValue(digits[4:6])
+		day := digitsValue(digits[6:8])
 		if month >= 1 && month <= 12 && day >= 1 && day <= 31 {
-			return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), true
+			return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), rest, true
 		}
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// 14-digit YYYYMMDDhhmmss format (with optional timezone)
 	if len(digits) != 14 || !isAllDigits(digits) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
-	year, _ := strconv.Atoi(digits[0:4])
-	month, _ := strconv.Atoi(digits[4:6])
-	day, _ := strconv.Atoi(digits[6:8])
-	hour, _ := strconv.Atoi(digits[8:10])
-	minute, _ := strconv.Atoi(digits[10:12])
-	second, _ := strconv.Atoi(digits[12:14])
+	year := digitsValue(digits[0:4])
+	month := digitsValue(digits[4:6])
+	day := digitsValue(digits[6:8])
+	hour := digitsValue(digits[8:10])
+	minute := digitsValue(digits[10:12])
+	second := digitsValue(digits[12:14])
 
 	if month < 1 || month > 12 || day < 1 || day > 31 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	tzLoc := loc
-	if len(parts) > 1 && parts[1] != "" {
-		if parsed, found := tryParseTimezone(strings.TrimSpace(parts[1])); found {
-			tzLoc = parsed
+	if rest != "" {
+		if parsed, found := tryParseTimezone(rest); found {
+			tzLoc, rest = parsed, ""
 		}
 	}
 
-	return time.Date(year, time.Month(month), day, hour, minute, second, 0, tzLoc), true
+	return time.Date(year, time.Month(month), day, hour, minute, second, 0, tzLoc), rest, true
 }
 
 // parseCompactTimeFormats handles PHP-specific compact time/date formats:
-// - "022233": 6-digit hhmmss time-only
+// - "022233": 6-digit hhmmss time-only, optionally with a fraction
 // - "2006167": 7-digit year+day-of-year (pgydotd)
-// - "t0222": 't' prefix + hhmm time
+// - "t0222": 't' prefix + time, "t9", "t0930", "t093045" or "t09:30:45.5"
 // - "22.49.12.42GMT": dotted time with optional fractional seconds and timezone
-func parseCompactTimeFormats(str string, now time.Time, loc *time.Location) (time.Time, bool) {
-	// "t" prefix + 4 digits = tHHMM time format
-	if len(str) >= 5 && str[0] == 't' && isAllDigits(str[1:5]) {
-		hour, _ := strconv.Atoi(str[1:3])
-		minute, _ := strconv.Atoi(str[3:5])
-		if IsValidTime(hour, minute, 0) {
+//
+// It also returns the text after a "t" time, which it leaves unread.
+func parseCompactTimeFormats(str string, now time.Time, loc *time.Location) (time.Time, string, bool) {
+	// "t" prefix + time
+	if len(str) >= 2 && str[0] == 't' && isDigit(str[1]) {
+		field, rest, _ := strings.Cut(str[1:], " ")
+		if hour, minute, second, nanos, consumed, ok := parseISO8601Time(field); ok && consumed == len(field) && hour < 24 {
 			y, m, d := now.Date()
-			return time.Date(y, m, d, hour, minute, 0, 0, loc), true
+			return time.Date(y, m, d, hour, minute, second, nanos, loc), strings.TrimSpace(rest), true
+		}
+	}
+
+	// 6-digit hhmmss with a fraction: "093045.5"
+	if len(str) > 7 && str[6] == '.' && isAllDigits(str[:6]) && isAllDigits(str[7:]) {
+		if hour, minute, second, nanos, consumed, ok := parseISO8601Time(str); ok && consumed == len(str) && hour < 24 {
+			y, m, d := now.Date()
+			return time.Date(y, m, d, hour, minute, second, nanos, loc), "", true
 		}
 	}
 
 	// Dotted time format: "HH.MM.SS[.frac][TZ]"
 	if len(str) >= 8 && str[2] == '.' && str[5] == '.' {
 		if isAllDigits(str[0:2]) && isAllDigits(str[3:5]) && str[6] >= '0' && str[6] <= '9' {
-			hour, _ := strconv.Atoi(str[0:2])
-			minute, _ := strconv.Atoi(str[3:5])
+			hour := digitsValue(str[0:2])
+			minute := digitsValue(str[3:5])
 			// Parse seconds (may be followed by .frac or tz)
 			pos := 6
 			for pos < len(str) && str[pos] >= '0' && str[pos] <= '9' {
@@ -104,7 +155,7 @@ func parseCompactTimeFormats(str string, now time.Time, loc *time.Location) (tim
 			}
 			second, _ := strconv.Atoi(str[6:pos])
 			if !IsValidTime(hour, minute, second) {
-				return time.Time{}, false
+				return time.Time{}, "", false
 			}
 			// Skip optional fractional part .NN
 			if pos < len(str) && str[pos] == '.' {
@@ -122,51 +173,51 @@ func parseCompactTimeFormats(str string, now time.Time, loc *time.Location) (tim
 				if parsed, found := tryParseTimezone(tzStr); found {
 					tzLoc = parsed
 				} else {
-					return time.Time{}, false
+					return time.Time{}, "", false
 				}
 			}
 			y, m, d := now.Date()
-			return time.Date(y, m, d, hour, minute, second, 0, tzLoc), true
+			return time.Date(y, m, d, hour, minute, second, 0, tzLoc), "", true
 		}
 	}
 
 	// All-digit formats
 	if !isAllDigits(str) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// 6-digit hhmmss: compact time-only format
 	if len(str) == 6 {
-		hour, _ := strconv.Atoi(str[0:2])
-		minute, _ := strconv.Atoi(str[2:4])
-		second, _ := strconv.Atoi(str[4:6])
+		hour := digitsValue(str[0:2])
+		minute := digitsValue(str[2:4])
+		second := digitsValue(str[4:6])
 		if IsValidTime(hour, minute, second) {
 			y, m, d := now.Date()
-			return time.Date(y, m, d, hour, minute, second, 0, loc), true
+			return time.Date(y, m, d, hour, minute, second, 0, loc), "", true
 		}
 	}
 
 	// 7-digit pgydotd: year + day-of-year (e.g., "2006167" = June 16, 2006)
 	if len(str) == 7 {
-		year, _ := strconv.Atoi(str[0:4])
-		doy, _ := strconv.Atoi(str[4:7])
+		year := digitsValue(str[0:4])
+		doy := digitsValue(str[4:7])
 		if year >= 1 && doy >= 1 && doy <= 366 {
 			t := time.Date(year, 1, 1, 0, 0, 0, 0, loc).AddDate(0, 0, doy-1)
 			if t.Year() == year { // ensure doy didn't overflow into next year
-				return t, true
+				return t, "", true
 			}
 		}
 	}
 
-	return time.Time{}, false
+	return time.Time{}, "", false
 }
 
 // parseMonthNameFormat parses formats like "Jan-15-2006" or "2006-Jan-15"
 func parseMonthNameFormat(str string, loc *time.Location) (time.Time, bool) {
-	parts := strings.Split(str, "-")
-	if len(parts) != 3 {
+	if strings.Count(str, "-") != 2 {
 		return time.Time{}, false
 	}
+	parts := strings.Split(str, "-")
 
 	// Try "Jan-15-2006" format: alpha-digits-4digits
 	if isAlpha(parts[0]) && len(parts[0]) >= 3 {
@@ -200,7 +251,7 @@ func parseMonthNameFormat(str string, loc *time.Location) (time.Time, bool) {
 		year, yearErr := strconv.Atoi(parts[2])
 
 		if dayErr == nil && yearErr == nil {
-			if year < 100 {
+			if len(parts[2]) <= 2 {
 				year = parseTwoDigitYear(year)
 			}
 			month, ok := getMonthByName(parts[1])
@@ -309,6 +360,39 @@ func parseHTTPLogFormat(str string, loc *time.Location) (time.Time, bool) {
 	return time.Date(year, month, day, hour, minute, second, 0, tz), true
 }
 
+// parseEXIFTimestamp parses the EXIF timestamp format "2003:10:29 10:11:12",
+// with an optional fraction of a second: "2003:10:29 10:11:12.25".
+func parseEXIFTimestamp(str string, loc *time.Location) (time.Time, bool) {
+	datePart, timePart, ok := strings.Cut(str, " ")
+	if !ok || len(datePart) != 10 || datePart[4] != ':' || datePart[7] != ':' {
+		return time.Time{}, false
+	}
+	year, month, day, ok := splitDigitFields(datePart, ':')
+	if !ok || len(year) != 4 || len(month) != 2 || len(day) != 2 {
+		return time.Time{}, false
+	}
+
+	timePart, frac, hasFrac := strings.Cut(timePart, ".")
+	hour, minute, second, ok := splitDigitFields(timePart, ':')
+	if !ok || len(hour) != 2 || len(minute) != 2 || len(second) != 2 {
+		return time.Time{}, false
+	}
+	nanos := 0
+	if hasFrac {
+		if frac == "" || len(frac) > 9 || !isAllDigits(frac) {
+			return time.Time{}, false
+		}
+		nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+	}
+
+	y, m, d := digitsValue(year), digitsValue(month), digitsValue(day)
+	h, mi, sec := digitsValue(hour), digitsValue(minute), digitsValue(second)
+	if !IsValidDate(y, m, d) || !IsValidTime(h, mi, sec) {
+		return time.Time{}, false
+	}
+	return time.Date(y, time.Month(m), d, h, mi, sec, nanos, loc), true
+}
+
 // stripOrdinalSuffix removes ordinal suffixes: "26th" → "26", "1st" → "1"
 func stripOrdinalSuffix(s string) string {
 	lower := strings.ToLower(s)
@@ -342,7 +426,10 @@ func getMonthByNameFlex(name string) (time.Month, bool) {
 // Examples: "11 Oct 2005", "11-MAY-1988 12:00:00AM", "11Oct2005",
 //
 //	"Sat 26th Nov 2005 18:18", "Thu, 20 Nov 2003 16:20:42 +0000"
-func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+//
+// It also returns the text after the date, time and zone, which it leaves
+// unread.
+func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time, string, bool) {
 	// Skip leading day-of-week like "Sat " or "Thursday, "
 	s, prefixDayNum, _ := stripWeekdayPrefix(str)
 	if prefixDayNum < 0 {
@@ -350,7 +437,7 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 	}
 
 	// Try to extract day, month, year from the remaining string
-	fields := strings.Fields(s)
+	fields := appendFields(make([]string, 0, fieldBufLen), s)
 	if len(fields) < 2 {
 		// Try compact DDMonYYYY (no spaces)
 		return parseDayMonthYearCompact(s, now, loc)
@@ -360,17 +447,19 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 
 	// Handle hyphen-separated date: "24-Jan-2019" or "24-Jan-19"
 	// (used by DATE_COOKIE and DATE_RFC850 formats)
-	if strings.Contains(fields[0], "-") {
+	if strings.Count(fields[0], "-") >= 2 {
+		// Replace the single hyphenated field with 3 separate fields
 		dateParts := strings.SplitN(fields[0], "-", 3)
-		if len(dateParts) == 3 {
-			// Replace the single hyphenated field with 3 separate fields
-			rest := fields[1:]
-			fields = append(dateParts, rest...)
-		}
+		rest := fields[1:]
+		fields = append(dateParts, rest...)
 	}
 
-	// Parse day (may include ordinal suffix)
+	// Parse day (may include ordinal suffix). A field starting with a letter
+	// is turned away before strconv, whose errors allocate.
 	dayStr := stripOrdinalSuffix(fields[idx])
+	if dayStr == "" || dayStr[0] > '9' {
+		return parseDayMonthYearCompact(s, now, loc)
+	}
 	day, err := strconv.Atoi(dayStr)
 	if err != nil || day < 1 || day > 31 {
 		// Day might be fused with month: "11Oct" in "11Oct 2005"
@@ -380,23 +469,23 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 
 	// Parse month name
 	if idx >= len(fields) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	month, ok := getMonthByNameFlex(fields[idx])
 	if !ok {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	idx++
 
 	// Parse year
 	if idx >= len(fields) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	year, err := strconv.Atoi(fields[idx])
 	if err != nil || year < 0 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
-	if year < 100 {
+	if len(fields[idx]) <= 2 {
 		year = parseTwoDigitYear(year)
 	}
 	idx++
@@ -408,6 +497,11 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 		timeStr := fields[idx]
 		if strings.Contains(timeStr, ":") {
 			h, m, sec, consumed, ok := parseFlexTime(timeStr)
+			// A fourth field, as in SQL Server's "10:30:45:123", is
+			// not a time of this format.
+			if ok && strings.HasPrefix(timeStr[consumed:], ":") {
+				return time.Time{}, "", false
+			}
 			if ok {
 				hour, minute, second = h, m, sec
 				idx++
@@ -446,12 +540,16 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 
 	// Parse optional timezone
 	tzLoc := loc
+	rest := ""
 	if idx < len(fields) {
 		tzStr := strings.Join(fields[idx:], " ")
-		if parsed, _, ok := parseNumericTimezoneOffset(tzStr); ok {
+		if parsed, n, ok := parseNumericTimezoneOffset(tzStr); ok {
 			tzLoc = parsed
+			rest = strings.TrimSpace(tzStr[n:])
 		} else if parsed, found := tryParseTimezone(tzStr); found {
 			tzLoc = parsed
+		} else {
+			rest = tzStr
 		}
 	}
 
@@ -466,16 +564,17 @@ func parseDayMonthYear(str string, now time.Time, loc *time.Location) (time.Time
 			}
 			result = time.Date(result.Year(), result.Month(), result.Day()+daysUntil, hour, minute, second, nanos, tzLoc)
 		}
-		return result, true
+		return result, rest, true
 	}
-	return time.Time{}, false
+	return time.Time{}, "", false
 }
 
 // parseDayMonthYearCompact parses "DDMonYYYY", "DDMon YYYY", or "DDMon" (no year = base year)
-func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+// with an optional time, and returns the text after them.
+func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (time.Time, string, bool) {
 	s := strings.TrimSpace(str)
 	if len(s) < 4 { // at least DMon
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Extract leading digits as day
@@ -484,7 +583,7 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 		dayEnd++
 	}
 	if dayEnd == 0 || dayEnd > 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	day, _ := strconv.Atoi(s[:dayEnd])
 
@@ -495,11 +594,11 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 		monthEnd++
 	}
 	if monthEnd-monthStart < 3 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	month, ok := getMonthByNameFlex(s[monthStart:monthEnd])
 	if !ok {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Rest may have optional space then year, then optional time
@@ -507,31 +606,33 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 	if rest == "" {
 		// No year — default to base year (e.g., "11Oct")
 		if day < 1 || day > 31 {
-			return time.Time{}, false
+			return time.Time{}, "", false
 		}
-		return time.Date(now.Year(), month, day, 0, 0, 0, 0, loc), true
+		return time.Date(now.Year(), month, day, 0, 0, 0, 0, loc), "", true
 	}
-	fields := strings.Fields(rest)
+	fields := appendFields(make([]string, 0, fieldBufLen), rest)
 	if len(fields) == 0 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	year, err := strconv.Atoi(fields[0])
 	if err != nil {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
-	if year < 100 {
+	if len(fields[0]) <= 2 {
 		year = parseTwoDigitYear(year)
 	}
 
 	hour, minute, second, nanos := 0, 0, 0, 0
 	fidx := 1
+	end := fidx // fields read so far
 
 	// Parse optional time
 	if fidx < len(fields) && strings.Contains(fields[fidx], ":") {
 		h, m, sec, consumed, ok := parseFlexTime(fields[fidx])
 		if ok {
 			hour, minute, second = h, m, sec
+			end = fidx + 1
 			// Check for AM/PM
 			remaining := fields[fidx][consumed:]
 			if ampm := strings.ToLower(remaining); ampm == "am" || ampm == "pm" {
@@ -540,6 +641,7 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 				ampm = strings.ToLower(fields[fidx+1])
 				if ampm == "am" || ampm == "pm" {
 					hour = applyAMPM(hour, ampm)
+					end++
 				}
 			}
 			// Handle fractional seconds
@@ -564,10 +666,10 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 	}
 
 	if day < 1 || day > 31 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
-	return time.Date(year, month, day, hour, minute, second, nanos, loc), true
+	return time.Date(year, month, day, hour, minute, second, nanos, loc), strings.Join(fields[end:], " "), true
 }
 
 // applyAMPM converts 12-hour time to 24-hour format
@@ -575,7 +677,7 @@ func parseDayMonthYearCompact(str string, now time.Time, loc *time.Location) (ti
 // parseMonthYearOnly parses "Oct 2001" or "2001 Oct" (month + year, day defaults to 1)
 // with optional trailing time: "october 2010 23:00", "2010 october 11:30 pm"
 func parseMonthYearOnly(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 2 {
 		return time.Time{}, false
 	}
@@ -595,8 +697,8 @@ func parseMonthYearOnly(str string, loc *time.Location) (time.Time, bool) {
 
 	// Try "Year Month"
 	if idx == 0 {
-		if y, err := strconv.Atoi(fields[0]); err == nil && (y >= 100 || len(fields[0]) >= 4) {
-			if m, ok := getMonthByNameFlex(fields[1]); ok {
+		if m, ok := getMonthByNameFlex(fields[1]); ok {
+			if y, err := strconv.Atoi(fields[0]); err == nil && (y >= 100 || len(fields[0]) >= 4) {
 				month, year = m, y
 				idx = 2
 			}
@@ -658,11 +760,12 @@ func parseMonthYearOnly(str string, loc *time.Location) (time.Time, bool) {
 }
 
 // parseTimeBeforeDate parses formats where time precedes the date:
-// "19:30 Dec 17 2005", "17:00 2004-01-01", "1pm Aug 1 GMT 2007"
-func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+// "19:30 Dec 17 2005", "17:00 2004-01-01", "1pm Aug 1 GMT 2007". It also
+// returns the text after the date, which it leaves unread.
+func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, string, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	var hour, minute, second int
@@ -673,7 +776,7 @@ func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, bool) {
 		var ok bool
 		hour, minute, second, _, ok = parseFlexTime(fields[0])
 		if !ok || !IsValidTime(hour, minute, second) {
-			return time.Time{}, false
+			return time.Time{}, "", false
 		}
 		// Check for AM/PM after the colon time
 		if len(fields) > 1 {
@@ -700,11 +803,11 @@ func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, bool) {
 			f = f[:len(f)-2]
 		}
 		if ampm == "" {
-			return time.Time{}, false
+			return time.Time{}, "", false
 		}
 		h, err := strconv.Atoi(f)
 		if err != nil || h < 1 || h > 12 {
-			return time.Time{}, false
+			return time.Time{}, "", false
 		}
 		hour = applyAMPM(h, ampm)
 	}
@@ -714,11 +817,11 @@ func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, bool) {
 
 	// Try ISO date
 	if t, ok := parseISOFormat(dateStr, loc); ok {
-		return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, loc), true
+		return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, loc), "", true
 	}
 
 	// Try month name date with optional timezone: "Aug 1 2007", "Aug 1 GMT 2007"
-	dateFields := strings.Fields(dateStr)
+	dateFields := appendFields(make([]string, 0, fieldBufLen), dateStr)
 	if len(dateFields) >= 2 {
 		if month, ok := getMonthByNameFlex(dateFields[0]); ok {
 			dayStr := stripOrdinalSuffix(strings.TrimSuffix(dateFields[1], ","))
@@ -745,50 +848,68 @@ func parseTimeBeforeDate(str string, loc *time.Location) (time.Time, bool) {
 					break
 				}
 
-				return time.Date(year, month, day, hour, minute, second, 0, tzLoc), true
+				return time.Date(year, month, day, hour, minute, second, 0, tzLoc), strings.Join(dateFields[fidx:], " "), true
 			}
 		}
 	}
 
-	return time.Time{}, false
+	return time.Time{}, "", false
 }
 
 // parseUSDateWithTime parses "MM/DD/YYYY H:MM AM" format (US date with 12-hour time)
-func parseUSDateWithTime(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+// and returns the text after it. The seconds may have a fraction of up to
+// nine digits, as in .NET's "1/15/2023 8:05:03.1234567 AM".
+func parseUSDateWithTime(str string, loc *time.Location) (time.Time, string, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// First field should be the date
 	t, ok := parseUSFormat(fields[0], loc)
 	if !ok {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Parse time
 	if len(fields) >= 2 && strings.Contains(fields[1], ":") {
 		hour, minute, second, consumed, ok := parseFlexTime(fields[1])
 		if !ok {
-			return time.Time{}, false
+			return time.Time{}, "", false
 		}
-		// Check for AM/PM
 		remaining := fields[1][consumed:]
+		nanos := 0
+		if len(remaining) > 1 && remaining[0] == '.' && isDigit(remaining[1]) {
+			n := 1
+			for n < len(remaining) && isDigit(remaining[n]) {
+				n++
+			}
+			if n > 10 {
+				return time.Time{}, "", false
+			}
+			nanos = digitsValue(remaining[1:n] + strings.Repeat("0", 10-n))
+			remaining = remaining[n:]
+		}
+		// Check for AM/PM
+		end := 2 // fields read
 		if ampm := strings.ToLower(remaining); ampm == "am" || ampm == "pm" {
 			hour = applyAMPM(hour, ampm)
 		} else if len(fields) >= 3 {
 			ampm = strings.ToLower(fields[2])
 			if ampm == "am" || ampm == "pm" {
 				hour = applyAMPM(hour, ampm)
+				end = 3
 			}
 		}
-		return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, loc), true
+		return time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, nanos, loc), strings.Join(fields[end:], " "), true
 	}
 
-	return time.Time{}, false
+	return time.Time{}, "", false
 }
 
-// parseFirstLastDayOfDate parses "first day of YYYY-MM" and "last day of YYYY-MM" patterns
+// parseFirstLastDayOfDate parses "first day of" and "last day of" followed by
+// an explicit month: "YYYY-MM", a month name or a quarter ("q2") with an
+// optional year and time, or a "+/-N month(s)/year(s)" offset.
 func parseFirstLastDayOfDate(str string, now time.Time, loc *time.Location) (time.Time, bool) {
 	lower := strings.ToLower(strings.TrimSpace(str))
 
@@ -807,21 +928,21 @@ func parseFirstLastDayOfDate(str string, now time.Time, loc *time.Location) (tim
 
 	// Try to parse rest as a relative expression: "+1 month", "-2 months"
 	if len(rest) > 0 && (rest[0] == '+' || rest[0] == '-') {
-		fields := strings.Fields(rest)
+		fields := appendFields(make([]string, 0, fieldBufLen), rest)
 		if len(fields) == 2 {
 			amount, err := strconv.Atoi(fields[0])
 			if err == nil {
 				unit := normalizeTimeUnit(fields[1])
+				// Use day=1 as reference to avoid overflow (e.g., Jan 31
+				// + 1 month would produce March 2 via time.AddDate, but
+				// "first day of +1 month" must land in Feb).
+				firstOfCurrent := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
 				var refTime time.Time
 				switch unit {
 				case UnitMonth:
-					// Use day=1 as reference to avoid overflow (e.g., Jan 31
-					// + 1 month would produce March 2 via time.AddDate, but
-					// "first day of +1 month" must land in Feb).
-					firstOfCurrent := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
 					refTime = firstOfCurrent.AddDate(0, amount, 0)
 				case UnitYear:
-					refTime = now.AddDate(amount, 0, 0)
+					refTime = firstOfCurrent.AddDate(amount, 0, 0)
 				default:
 					return time.Time{}, false
 				}
@@ -845,10 +966,20 @@ func parseFirstLastDayOfDate(str string, now time.Time, loc *time.Location) (tim
 		}
 	}
 
-	// Try to parse rest as a month name with optional year and optional trailing time
-	fields := strings.Fields(rest)
+	// Try to parse rest as a month name or quarter with optional year and
+	// optional trailing time
+	fields := appendFields(make([]string, 0, fieldBufLen), rest)
 	if len(fields) >= 1 {
-		if month, ok := getMonthByNameFlex(fields[0]); ok {
+		month, ok := getMonthByNameFlex(fields[0])
+		if q, isQuarter := quarterNumber(fields[0]); isQuarter {
+			// The first day of a quarter is in its first month, the last
+			// day in its third.
+			month, ok = time.Month(3*q-2), true
+			if isLast {
+				month += 2
+			}
+		}
+		if ok {
 			idx := 1
 			year := now.Year()
 			if idx < len(fields) {
@@ -878,43 +1009,99 @@ func parseFirstLastDayOfDate(str string, now time.Time, loc *time.Location) (tim
 	return time.Time{}, false
 }
 
+// quarterNumber returns the quarter named by "q1" to "q4".
+func quarterNumber(s string) (int, bool) {
+	if len(s) != 2 || (s[0] != 'q' && s[0] != 'Q') || s[1] < '1' || s[1] > '4' {
+		return 0, false
+	}
+	return int(s[1] - '0'), true
+}
+
 // parseOrdinalDate parses "26th Nov" or "December 4th, 2005" etc.
 // with optional time. Handles month name followed by ordinal day or ordinal day followed by month.
-func parseOrdinalDate(str string, now time.Time, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+// It also returns the text after them, which it leaves unread.
+func parseOrdinalDate(str string, now time.Time, loc *time.Location) (time.Time, string, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 2 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Try "DDth Mon [YYYY] [time]" format
 	dayStr := stripOrdinalSuffix(fields[0])
-	if day, err := strconv.Atoi(dayStr); err == nil && day >= 1 && day <= 31 {
-		if month, ok := getMonthByNameFlex(fields[1]); ok {
+	if month, ok := getMonthByNameFlex(fields[1]); ok {
+		if day, err := strconv.Atoi(dayStr); err == nil && day >= 1 && day <= 31 {
 			year := now.Year()
 			fidx := 2
-			if fidx < len(fields) {
+			if fidx < len(fields) && !isMeridianTime(fields[fidx:]) {
 				if y, err := strconv.Atoi(fields[fidx]); err == nil {
 					year = y
 					fidx++
 				}
 			}
 			hour, minute, second := 0, 0, 0
-			if fidx < len(fields) && strings.Contains(fields[fidx], ":") {
+			if h, m, s, n, ok := parseMeridianTime(fields[fidx:]); ok {
+				hour, minute, second = h, m, s
+				fidx += n
+			} else if fidx < len(fields) && (fields[fidx] == "noon" || fields[fidx] == "midnight") {
+				if fields[fidx] == "noon" {
+					hour = 12
+				}
+				fidx++
+			} else if fidx < len(fields) && strings.Contains(fields[fidx], ":") {
 				h, m, s, _, ok := parseFlexTime(fields[fidx])
 				if ok {
 					hour, minute, second = h, m, s
+					fidx++
 				}
 			}
-			return time.Date(year, month, day, hour, minute, second, 0, loc), true
+			return time.Date(year, month, day, hour, minute, second, 0, loc), strings.Join(fields[fidx:], " "), true
 		}
 	}
 
-	return time.Time{}, false
+	return time.Time{}, "", false
+}
+
+// parseMeridianTime parses the 12-hour time starting fields, "3pm",
+// "3:30pm" or "3:30:15 p.m.", and returns it in 24-hour format with the
+// number of fields it takes.
+func parseMeridianTime(fields []string) (hour, minute, second, n int, ok bool) {
+	if len(fields) == 0 {
+		return 0, 0, 0, 0, false
+	}
+	n = 1
+	timeStr := fields[0]
+	if len(fields) > 1 {
+		switch fields[1] {
+		case "am", "pm", "a.m.", "p.m.":
+			timeStr, n = timeStr+fields[1], 2
+		}
+	}
+	timeStr, ampm := cutMeridian(timeStr)
+	if ampm == "" {
+		return 0, 0, 0, 0, false
+	}
+	consumed := len(timeStr)
+	switch {
+	case strings.Contains(timeStr, ":"):
+		hour, minute, second, consumed, ok = parseFlexTime(timeStr)
+	case len(timeStr) <= 2 && isAllDigits(timeStr):
+		hour, ok = digitsValue(timeStr), true
+	}
+	if !ok || !applyMeridian(&hour, ampm, consumed == len(timeStr)) {
+		return 0, 0, 0, 0, false
+	}
+	return hour, minute, second, n, true
+}
+
+// isMeridianTime reports whether fields start with a 12-hour time.
+func isMeridianTime(fields []string) bool {
+	_, _, _, _, ok := parseMeridianTime(fields)
+	return ok
 }
 
 // parseMonthDayTimeYear parses "Dec 17 19:30 2005" (month day time year)
 func parseMonthDayTimeYear(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 4 {
 		return time.Time{}, false
 	}
@@ -973,7 +1160,7 @@ func parseDateTimeTZRelative(str string, loc *time.Location) (time.Time, bool) {
 		for i := len(remaining) - 1; i > 0; i-- {
 			if (remaining[i] == '+' || remaining[i] == '-') && remaining[i-1] == ' ' {
 				relPart := remaining[i:]
-				relFields := strings.Fields(relPart)
+				relFields := appendFields(make([]string, 0, fieldBufLen), relPart)
 				if len(relFields) != 2 {
 					continue
 				}
@@ -983,7 +1170,7 @@ func parseDateTimeTZRelative(str string, loc *time.Location) (time.Time, bool) {
 				}
 				unit := normalizeTimeUnit(relFields[1])
 				switch unit {
-				case UnitDay, UnitWeek, UnitWeekDay, UnitMonth, UnitYear,
+				case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear,
 					UnitHour, UnitMinute, UnitSecond:
 					// Valid relative expression — strip it and continue
 					rels = append(rels, relExpr{amount, unit})
@@ -1014,7 +1201,8 @@ func parseDateTimeTZRelative(str string, loc *time.Location) (time.Time, bool) {
 			if t, ok = parseISODateTimeWithTimezone(datePart, loc); !ok {
 				if t, ok = parseDateWithTZ(datePart, loc); !ok {
 					if t, ok = parseISOFormat(datePart, loc); !ok {
-						if t, ok = parseDayMonthYear(datePart, time.Now(), loc); !ok {
+						var rest string
+						if t, rest, ok = parseDayMonthYear(datePart, time.Now(), loc); !ok || rest != "" {
 							return time.Time{}, false
 						}
 					}
@@ -1033,7 +1221,7 @@ func parseDateTimeTZRelative(str string, loc *time.Location) (time.Time, bool) {
 
 // parseDateWithTZ parses "YYYY-MM-DD TZname" (date + timezone without time)
 func parseDateWithTZ(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) != 2 {
 		return time.Time{}, false
 	}
@@ -1084,20 +1272,28 @@ func parseFrontBackOf(str string, now time.Time, loc *time.Location) (time.Time,
 	}
 
 	year, month, day := now.Date()
+	hour, minute := frontBackOfTime(isFront, hour, ampm)
+	return time.Date(year, month, day, hour, minute, 0, 0, loc), true
+}
+
+// frontBackOfTime returns the hour and minute of "front of hour" or "back
+// of hour", ampm being "", "am" or "pm". The hour returned may be -1 or 24
+// and up, for the previous or next day.
+func frontBackOfTime(isFront bool, hour int, ampm string) (int, int) {
 	if isFront {
 		// PHP: "front of" with pm adds 12 directly (12pm→24, 1pm→13)
 		if ampm == "pm" {
 			hour += 12
 		}
 		// "front of N" = (N-1):45
-		return time.Date(year, month, day, hour-1, 45, 0, 0, loc), true
+		return hour - 1, 45
 	}
 	// "back of" uses standard AM/PM conversion
 	if ampm != "" {
 		hour = applyAMPM(hour, ampm)
 	}
 	// "back of N" = N:15
-	return time.Date(year, month, day, hour, 15, 0, 0, loc), true
+	return hour, 15
 }
 
 // romanNumeralMonths maps Roman numerals to month numbers
@@ -1108,37 +1304,38 @@ var romanNumeralMonths = map[string]time.Month{
 	"x": time.October, "xi": time.November, "xii": time.December,
 }
 
-// parseRomanNumeralDate parses dates with Roman numeral months: "20 VI. 2005", "1 III 2010"
-func parseRomanNumeralDate(str string, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
+// parseRomanNumeralDate parses dates with Roman numeral months: "20 VI. 2005", "1 III 2010",
+// and returns the text after them.
+func parseRomanNumeralDate(str string, loc *time.Location) (time.Time, string, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
 	if len(fields) < 3 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Parse day
 	day, err := strconv.Atoi(fields[0])
 	if err != nil || day < 1 || day > 31 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Parse Roman numeral month (strip trailing period)
 	monthStr := strings.ToLower(strings.TrimSuffix(fields[1], "."))
 	month, ok := romanNumeralMonths[monthStr]
 	if !ok {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	// Parse year
 	year, err := strconv.Atoi(fields[2])
 	if err != nil {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
 	if !IsValidDate(year, int(month), day) {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 
-	return time.Date(year, month, day, 0, 0, 0, 0, loc), true
+	return time.Date(year, month, day, 0, 0, 0, 0, loc), strings.Join(fields[3:], " "), true
 }
 
 // parseNumberedWeekday parses formats like "1 Monday December 2008", "second Monday December 2008"
@@ -1146,37 +1343,34 @@ func parseRomanNumeralDate(str string, loc *time.Location) (time.Time, bool) {
 // Also handles "+N week Thursday Nov 2007" (week offset + weekday + month + year)
 // Also handles "3 tuesday" or "third tuesday" (no month context — count forward from now)
 // Also handles "third tuesday of this month" (calendar month context)
+//
+// The input is read over its token stream: ordinal, weekday, optional "of",
+// month (or "this/next/last month/year"), optional year and time.
 func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.Time, bool) {
-	fields := strings.Fields(str)
-	if len(fields) < 2 {
-		return time.Time{}, false
-	}
-
-	idx := 0
+	var buf [16]Token
+	c := newTokenCursor(str, buf[:])
 
 	// Parse the ordinal (numeric, word, or implicit from bare weekday)
-	ordinal, isWordOrdinal, idx, ok := parseOrdinalPrefix(fields, idx)
+	ordinal, isWordOrdinal, implicit, ok := readWeekdayOrdinal(&c)
 	if !ok {
 		return time.Time{}, false
 	}
 
 	// Handle "+N week(s) Weekday Month Year" — skip "week(s)" after numeric ordinal
-	if idx < len(fields) {
-		unit := normalizeTimeUnit(fields[idx])
-		if unit == UnitWeek {
-			isWordOrdinal = true // week-based ordinals use same semantics as word ordinals
-			idx++
-		}
+	if w, ok := c.peekWord(); ok && normalizeTimeUnit(w) == UnitWeek {
+		isWordOrdinal = true // week-based ordinals use same semantics as word ordinals
+		c.word()
 	}
 
 	// Parse the day of week or "day" keyword
-	if idx >= len(fields) {
+	w, ok := c.word()
+	if !ok {
 		return time.Time{}, false
 	}
 	isDayOfMonth := false
-	dayOfWeek := getDayOfWeek(fields[idx])
+	dayOfWeek := getDayOfWeek(w)
 	if dayOfWeek < 0 {
-		if strings.ToLower(fields[idx]) == "day" {
+		if strings.EqualFold(w, "day") {
 			// PHP only supports "first day of" and "last day of"
 			if ordinal != 1 && ordinal != -1 {
 				return time.Time{}, false
@@ -1186,18 +1380,21 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 			return time.Time{}, false
 		}
 	}
-	idx++
+	if implicit && c.done() {
+		// A bare weekday on its own is handled elsewhere.
+		return time.Time{}, false
+	}
 
 	// Check for optional "of" — its presence changes ordinal semantics
 	hasOf := false
-	if idx < len(fields) && strings.ToLower(fields[idx]) == "of" {
+	if w, ok := c.peekWord(); ok && strings.EqualFold(w, "of") {
 		hasOf = true
-		idx++
+		c.word()
 	}
 
 	// No month context: "3 tuesday", "third tuesday" — count forward from base date
 	// Exclude ordinal=-1 ("last weekday") — that's handled by the token parser.
-	if idx >= len(fields) && !hasOf && !isDayOfMonth && ordinal > 0 {
+	if c.done() && !hasOf && !isDayOfMonth && ordinal > 0 {
 		currentDay := int(now.Weekday())
 		daysUntil := (dayOfWeek - currentDay + 7) % 7
 		if isWordOrdinal && daysUntil == 0 {
@@ -1212,7 +1409,8 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 	}
 
 	// Parse the month context: either a literal month name or "this/next/last month/year"
-	if idx >= len(fields) {
+	direction, ok := c.word()
+	if !ok {
 		return time.Time{}, false
 	}
 
@@ -1220,17 +1418,15 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 	var year int
 	relativeYears := 0 // PHP applies year offset AFTER weekday adjustment
 
-	direction := strings.ToLower(fields[idx])
+	direction = strings.ToLower(direction)
 	if direction == DirectionNext || direction == DirectionLast || direction == "this" {
 		// Relative month/year: "next month", "last year", etc.
-		idx++
-		if idx >= len(fields) {
+		unitWord, ok := c.word()
+		if !ok {
 			return time.Time{}, false
 		}
-		unit := normalizeTimeUnit(fields[idx])
-		idx++
 
-		switch unit {
+		switch normalizeTimeUnit(unitWord) {
 		case UnitMonth:
 			// Use day=1 as reference to avoid overflow when base day doesn't
 			// exist in the target month (e.g., Jan 31 → "next month" must
@@ -1266,38 +1462,39 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 	} else {
 		// Literal month name
 		var ok bool
-		month, ok = getMonthByName(fields[idx])
+		month, ok = getMonthByName(direction)
 		if !ok {
 			return time.Time{}, false
 		}
-		idx++
 
 		// Parse the optional year
 		year = now.Year()
-		if idx < len(fields) {
-			var err error
-			year, err = strconv.Atoi(fields[idx])
-			if err != nil || year < 1 || year > 9999 {
+		if !c.done() {
+			y, ok := c.number()
+			if !ok || len(y) > maxDigitField {
+				return time.Time{}, false
+			}
+			year = digitsValue(y)
+			if year < 1 || year > 9999 {
 				return time.Time{}, false
 			}
-			idx++
 		}
 	}
 
 	// Parse optional trailing time expression (HH:MM[:SS])
 	trailingHour, trailingMinute, trailingSecond := 0, 0, 0
 	hasTrailingTime := false
-	if idx < len(fields) {
-		h, m, s, consumed, ok := parseFlexTime(fields[idx])
-		if ok && consumed == len(fields[idx]) {
+	if rest := c.rest(); rest != "" && strings.IndexByte(rest, ' ') < 0 {
+		h, m, s, consumed, ok := parseFlexTime(rest)
+		if ok && consumed == len(rest) {
 			trailingHour, trailingMinute, trailingSecond = h, m, s
 			hasTrailingTime = true
-			idx++
+			c.skipAll()
 		}
 	}
 
 	// Make sure we consumed all fields
-	if idx != len(fields) {
+	if !c.done() {
 		return time.Time{}, false
 	}
 
@@ -1367,7 +1564,7 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 					daysBack = 7
 				}
 				resultDay = 1 - daysBack
-				return time.Date(year, month, resultDay, 0, 0, 0, 0, loc), true
+				return time.Date(year, month, resultDay, trailingHour, trailingMinute, trailingSecond, 0, loc), true
 			}
 		} else {
 			return time.Time{}, false
@@ -1387,3 +1584,58 @@ func parseNumberedWeekday(str string, now time.Time, loc *time.Location) (time.T
 	// This means the day-of-week may shift when the year changes.
 	return time.Date(year+relativeYears, month, resultDay, h, mi, s, 0, loc), true
 }
+
+// readWeekdayOrdinal reads the ordinal leading a numbered weekday expression:
+// a number ("3", "+2"), a suffixed number ("3rd"), an ordinal word ("third")
+// or "last" (-1). A bare weekday name implies 1 and is left unconsumed, which
+// implicit reports. isWord is set for the word and suffixed forms, which
+// follow PHP's stricter "skip the current day" semantics.
+func readWeekdayOrdinal(c *tokenCursor) (ordinal int, isWord, implicit, ok bool) {
+	if c.done() {
+		return 0, false, false, false
+	}
+	save := c.i
+	switch tok := c.toks[c.i]; tok.Typ {
+	case TypeOperator, TypeNumber:
+		if tok.Val == "+" {
+			c.i++
+		} else if tok.Typ == TypeOperator {
+			return 0, false, false, false
+		}
+		if c.i >= len(c.toks) || c.toks[c.i].Typ != TypeNumber || len(c.toks[c.i].Val) > maxDigitField {
+			return 0, false, false, false
+		}
+		digits := c.toks[c.i].Val
+		n := digitsValue(digits)
+		c.i++
+		if c.fieldEndsAt(c.i) {
+			if n <= 0 || n > 53 {
+				return 0, false, false, false
+			}
+			return n, false, false, true
+		}
+		// "1st" .. "12th"
+		if save+1 != c.i || c.toks[c.i].Typ != TypeString || !c.fieldEndsAt(c.i+1) ||
+			digits[0] == '0' || n > 12 || !strings.EqualFold(c.toks[c.i].Val, ordinalSuffix(n)) {
+			return 0, false, false, false
+		}
+		c.i++
+		return n, true, false, true
+	case TypeString:
+		w, ok := c.word()
+		if !ok {
+			return 0, false, false, false
+		}
+		if n := ordinalWordToNumber(w); n > 0 {
+			return n, true, false, true
+		}
+		if strings.EqualFold(w, DirectionLast) {
+			return -1, false, false, true
+		}
+		if getDayOfWeek(w) >= 0 {
+			c.i = save // don't advance — weekday parsed next
+			return 1, false, true, true
+		}
+	}
+	return 0, false, false, false
+}
@@ -0,0 +1,45 @@
+//go:build strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// extendedFormatParsers is empty in minimal builds, which only understand
+// PHP's strtotime grammar.
+var extendedFormatParsers []formatParser
+
+// extendedCacheKey returns an empty key in minimal builds, which have none of
+// the options it keys on.
+func extendedCacheKey(opts []Option) (string, bool) {
+	return "", true
+}
+
+// isHolidayWord reports false in minimal builds, which do not parse holiday
+// names.
+func isHolidayWord(word string) bool {
+	return false
+}
+
+// isPostgresLiteral reports false in minimal builds, which do not parse
+// PostgreSQL's special values.
+func isPostgresLiteral(str string) bool {
+	return false
+}
+
+// parseCalendarNumericInto reports false in minimal builds, which only read
+// Gregorian dates.
+func parseCalendarNumericInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	return false
+}
+
+// translateLocale returns str unchanged in minimal builds, which only read
+// English.
+func translateLocale(str string, opts []Option) string {
+	return str
+}
+
+// localeError returns err unchanged in minimal builds, which translate
+// nothing.
+func localeError(str string, opts []Option, err error) error {
+	return err
+}
@@ -0,0 +1,94 @@
+package strtotime
+
+import "time"
+
+// The years an ExtendedYears date may have: the range over which time.Time
+// values can be built and converted to and from Unix time.
+const (
+	minExtendedYear = -292277022399
+	maxExtendedYear = 292277026595
+)
+
+// parseExtendedYearInto parses ISO 8601 expanded dates, "[+-]YYYYY-MM-DD"
+// with more than four year digits, optionally followed by a "[ T]hh:mm[:ss]"
+// time and a "z" or numeric UTC offset. Unlike the regular pipeline, which
+// follows PHP, it reads an unsigned five-digit "12345-06-07" as a year, and
+// years time.Time cannot represent are reported as an error rather than
+// wrapping around. It only runs under the ExtendedYears option.
+func parseExtendedYearInto(str string, loc *time.Location, pd *ParsedDate) bool {
+	i := 0
+	if str[0] == '+' || str[0] == '-' {
+		i = 1
+	}
+	j := i
+	for j < len(str) && isDigit(str[j]) {
+		j++
+	}
+	if j-i < 5 || j == len(str) || str[j] != '-' {
+		return false
+	}
+	month, k, ok := readDigitField(str, j+1, 2, 2)
+	if !ok || k >= len(str) || str[k] != '-' {
+		return false
+	}
+	day, k, ok := readDigitField(str, k+1, 2, 2)
+	if !ok {
+		return false
+	}
+
+	var hour, minute, second, nanos int
+	rest := str[k:]
+	if rest != "" {
+		if rest[0] != ' ' && rest[0] != 't' && rest[0] != 'T' {
+			return false
+		}
+		var n int
+		if hour, minute, second, nanos, n, ok = parseISO8601Time(rest[1:]); !ok {
+			return false
+		}
+		rest = trimLeftSpace(rest[1+n:])
+	}
+	tzLoc := loc
+	if rest != "" {
+		l, n, ok := parseNumericTimezoneOffset(rest)
+		if !ok || n != len(rest) {
+			return false
+		}
+		tzLoc = l
+	}
+
+	// From here on the input is an expanded date, and a bad one is an error.
+	if j-i > 12 {
+		pd.AddError(0, "year out of range")
+		return true
+	}
+	year := digitsValue(str[i:j])
+	if str[0] == '-' {
+		year = -year
+	}
+	if year < minExtendedYear || year > maxExtendedYear {
+		pd.AddError(0, "year out of range")
+		return true
+	}
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(year, time.Month(month)) {
+		pd.cause = NewInvalidDateError(year, month, day)
+		return false
+	}
+	if !IsValidTime(hour, minute, second) {
+		return false
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nanos, tzLoc)
+	pd.SetDate(year, month, day)
+	if len(str) > k {
+		pd.SetTime(hour, minute, second)
+		if nanos > 0 {
+			pd.SetFraction(float64(nanos) / 1e9)
+		}
+	}
+	if rest != "" {
+		recordISO8601TZ(rest, pd, t)
+	}
+	pd.setMaterialized(t)
+	return true
+}
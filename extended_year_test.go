@@ -0,0 +1,47 @@
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExtendedYears(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	opts := []Option{Rel(base), InTZ(time.UTC), ExtendedYears()}
+
+	tests := map[string]time.Time{
+		"12345-06-07":                  time.Date(12345, 6, 7, 0, 0, 0, 0, time.UTC),
+		"+10000-01-01t00:00:00z":       time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+		"-100000-02-29 10:30":          time.Date(-100000, 2, 29, 10, 30, 0, 0, time.UTC),
+		"292277026595-12-31":           time.Date(292277026595, 12, 31, 0, 0, 0, 0, time.UTC),
+		"-292277022399-01-01":          time.Date(-292277022399, 1, 1, 0, 0, 0, 0, time.UTC),
+		"12345-06-07 10:00:00.5+02:00": time.Date(12345, 6, 7, 8, 0, 0, 5e8, time.UTC),
+		"2024-01-15 10:00":             time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		"tomorrow":                     time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, opts...)
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"292277026596-01-01", "-292277022400-01-01", "+1000000000000-01-01"} {
+		if _, err := StrToTime(input, opts...); err == nil {
+			t.Errorf("StrToTime(%q) should fail with ExtendedYears", input)
+		}
+	}
+	if _, err := StrToTime("12345-02-30", opts...); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("StrToTime(\"12345-02-30\") error = %v, want ErrInvalidDate", err)
+	}
+
+	// Without the option, the PHP reading of the input is kept.
+	if got, err := StrToTime("12345-06-07", Rel(base), InTZ(time.UTC)); err != nil || got.Year() != 2024 {
+		t.Errorf("StrToTime(\"12345-06-07\") = %v, %v, want the PHP reading", got, err)
+	}
+}
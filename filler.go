@@ -0,0 +1,89 @@
+package strtotime
+
+import "strings"
+
+// dropFillerWords removes the words English date expressions carry that the
+// grammar has no place for: "the" ("the 4th of july", "the next monday"),
+// "at" and "on" ("on monday at 10am"), and "of" between a day and a month
+// ("4th of july"). After "of" and before a unit, "the" reads as "this", so
+// "last day of the month" is the last day of this month. A "the" after a
+// weekday is kept for the weekday coincidences ("friday the 13th"). It
+// reports whether any word was dropped.
+func dropFillerWords(str string) (string, bool) {
+	cleaned, first := dropFillers(str)
+	return cleaned, first >= 0
+}
+
+// dropFillers is dropFillerWords, returning the byte offset in str of the
+// first word dropped, or -1 when none is.
+func dropFillers(str string) (string, int) {
+	words := strings.Split(str, " ")
+	kept := make([]string, 0, len(words))
+	first, pos := -1, 0
+	for i, w := range words {
+		if i > 0 {
+			pos += len(words[i-1]) + 1
+		}
+		var prev, next string
+		if len(kept) > 0 {
+			prev = kept[len(kept)-1]
+		}
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+
+		switch {
+		case w == "the" && prev == "of" && classifyWord(next)&kindUnit != 0:
+			kept = append(kept, "this")
+		case w == "the" && getDayOfWeek(prev) < 0,
+			w == "at" || w == "on",
+			w == "of" && isDayOfMonthWord(prev) && classifyWord(next)&kindMonth != 0:
+		default:
+			kept = append(kept, w)
+			continue
+		}
+		if first < 0 {
+			first = pos
+		}
+	}
+	if first < 0 || len(kept) == 0 {
+		return "", -1
+	}
+	return strings.Join(kept, " "), first
+}
+
+// isDayOfMonthWord reports whether w is a day of the month, with or without
+// its ordinal suffix ("4", "4th", "21st").
+func isDayOfMonthWord(w string) bool {
+	i := 0
+	for i < len(w) && i < 2 && isDigit(w[i]) {
+		i++
+	}
+	if i == 0 {
+		return false
+	}
+	n := digitsValue(w[:i])
+	return n >= 1 && n <= 31 && (i == len(w) || w[i:] == ordinalSuffix(n))
+}
+
+// softenCommas replaces the commas that separate the clauses of str with
+// spaces, as in "monday, 3pm" or "jan 15, 2023, 10:30 am", the way PHP
+// skips them between tokens. Commas between two digits are kept, as they
+// may be decimal commas ("10:30:45,5"). It reports whether any comma was
+// replaced.
+func softenCommas(str string) (string, bool) {
+	var b []byte
+	for i := 0; i < len(str); i++ {
+		if str[i] != ',' || (i > 0 && i+1 < len(str) && isDigit(str[i-1]) && isDigit(str[i+1])) {
+			continue
+		}
+		if b == nil {
+			b = []byte(str)
+		}
+		b[i] = ' '
+	}
+	if b == nil {
+		return "", false
+	}
+	return collapseSpaces(strings.TrimSpace(string(b))), true
+}
@@ -0,0 +1,65 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFillerWords(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"the 4th of July":       time.Date(2024, 7, 4, 0, 0, 0, 0, time.UTC),
+		"4th of July 2023":      time.Date(2023, 7, 4, 0, 0, 0, 0, time.UTC),
+		"the next Monday":       time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		"last day of the month": time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC),
+		"on Monday at 10am":     time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC),
+		"next Friday at 3:30pm": time.Date(2024, 3, 8, 15, 30, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"the", "the zzz", "the 32nd of july"} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+
+	// DateParse keeps reporting the filler words as PHP does.
+	if pd := DateParse("4th of July 2023"); pd.ErrorCount == 0 {
+		t.Errorf("DateParse(\"4th of July 2023\") has no errors")
+	}
+}
+
+func TestCommas(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"Jan 15, 2023, 10:30 AM":     time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"Monday, 3pm":                time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC),
+		"monday,3pm":                 time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC),
+		"2023-01-15, 10:30":          time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"tomorrow, 10am":             time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+		"15 Jan 2023, 10:30, UTC":    time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"on Monday, at 3pm":          time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC),
+		"Jan 15, 2023 10:30 AM, EST": time.Date(2023, 1, 15, 15, 30, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base), InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{",", "1,5 days"} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
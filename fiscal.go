@@ -0,0 +1,111 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// FiscalYearStart sets the month fiscal years start in (default: January,
+// making them calendar years). A fiscal year is named after the calendar
+// year it ends in: with FiscalYearStart(time.October), FY2024 runs from
+// October 1st, 2023 to September 30th, 2024, and its second quarter starts
+// on January 1st, 2024.
+func FiscalYearStart(m time.Month) Option {
+	return fiscalYearStartOption{month: m}
+}
+
+// fiscalYearStartOption is an internal type for the FiscalYearStart option
+type fiscalYearStartOption struct {
+	month time.Month
+}
+
+func (fiscalYearStartOption) isOption() bool {
+	return true
+}
+
+// fiscalYearStart returns the month fiscal years start in under opts.
+func fiscalYearStart(opts []Option) time.Month {
+	start := time.January
+	for _, opt := range opts {
+		if o, ok := opt.(fiscalYearStartOption); ok && o.month >= time.January && o.month <= time.December {
+			start = o.month
+		}
+	}
+	return start
+}
+
+// fiscalPeriodStart returns noon on the first day of the year (months 12)
+// or quarter (months 3) containing t, with years starting in month start.
+func fiscalPeriodStart(t time.Time, months int, start time.Month, loc *time.Location) time.Time {
+	y, m, _ := t.Date()
+	into := (int(m) - int(start) + 12) % 12 % months
+	return time.Date(y, m-time.Month(into), 1, 12, 0, 0, 0, loc)
+}
+
+// parseFiscalPeriod parses a fiscal year, "fy2024", "fy24" or "fy 2024",
+// optionally with a quarter before or after it: "fy24 q2", "q2 fy2024". It
+// returns the first day of the period and of the one after it, at noon.
+func parseFiscalPeriod(fields []string, start time.Month, loc *time.Location) (first, next time.Time, ok bool) {
+	quarter := 0
+	if len(fields) > 0 {
+		if q, isQuarter := quarterNumber(fields[0]); isQuarter {
+			quarter, fields = q, fields[1:]
+		} else if q, isQuarter := quarterNumber(fields[len(fields)-1]); isQuarter {
+			quarter, fields = q, fields[:len(fields)-1]
+		}
+	}
+
+	var digits string
+	switch {
+	case len(fields) == 1 && strings.HasPrefix(fields[0], "fy"):
+		digits = fields[0][2:]
+	case len(fields) == 2 && fields[0] == "fy":
+		digits = fields[1]
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+	if (len(digits) != 2 && len(digits) != 4) || !isAllDigits(digits) {
+		return time.Time{}, time.Time{}, false
+	}
+	year := digitsValue(digits)
+	if len(digits) == 2 {
+		year = parseTwoDigitYear(year)
+	}
+
+	// FY<year> ends in <year>, so it starts in the year before unless it
+	// is a calendar year.
+	if start != time.January {
+		year--
+	}
+	first = time.Date(year, start, 1, 12, 0, 0, 0, loc)
+	if quarter == 0 {
+		return first, first.AddDate(1, 0, 0), true
+	}
+	first = first.AddDate(0, 3*(quarter-1), 0)
+	return first, first.AddDate(0, 3, 0), true
+}
+
+// parseFiscal parses fiscal years and quarters ("FY2024", "FY24 Q2", "Q2
+// FY24") and returns midnight on their first day. Fiscal years start in the
+// month set with FiscalYearStart.
+func parseFiscal(str string, loc *time.Location, opts []Option) (time.Time, bool) {
+	first, _, ok := parseFiscalPeriod(appendFields(make([]string, 0, fieldBufLen), str), fiscalYearStart(opts), loc)
+	if !ok {
+		return time.Time{}, false
+	}
+	y, m, d := first.Date()
+	return startOfDate(y, m, d, loc), true
+}
+
+func parseFiscalInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseFiscal(str, loc, opts)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(0, 0, 0)
+	pd.setMaterialized(t)
+	return true
+}
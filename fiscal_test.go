@@ -0,0 +1,54 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscal(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	october := FiscalYearStart(time.October)
+
+	tests := []struct {
+		input string
+		opts  []Option
+		want  string
+	}{
+		{"FY2024", nil, "2024-01-01 00:00:00"},
+		{"FY24 Q2", nil, "2024-04-01 00:00:00"},
+		{"Q3 FY 2024", nil, "2024-07-01 00:00:00"},
+		{"start of fiscal year", nil, "2024-01-01 00:00:00"},
+		{"end of fiscal quarter", nil, "2024-03-31 23:59:59"},
+		{"start of next quarter", nil, "2024-04-01 00:00:00"},
+
+		{"FY2024", []Option{october}, "2023-10-01 00:00:00"},
+		{"FY24 Q2", []Option{october}, "2024-01-01 00:00:00"},
+		{"fy25 q4", []Option{october}, "2025-07-01 00:00:00"},
+		{"start of fiscal year", []Option{october}, "2023-10-01 00:00:00"},
+		{"end of fiscal year", []Option{october}, "2024-09-30 23:59:59"},
+		{"start of next fiscal year", []Option{october}, "2024-10-01 00:00:00"},
+		{"end of last fiscal quarter", []Option{october}, "2023-12-31 23:59:59"},
+		{"end of FY2024", []Option{october}, "2024-09-30 23:59:59"},
+		{"start of FY24 Q3", []Option{FiscalYearStart(time.April)}, "2023-10-01 00:00:00"},
+		{"start of quarter", []Option{october}, "2024-01-01 00:00:00"},
+	}
+	for _, tt := range tests {
+		opts := append([]Option{Rel(base), InTZ(time.UTC)}, tt.opts...)
+		got, err := StrToTime(tt.input, opts...)
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != tt.want {
+			t.Errorf("%q = %s, want %s", tt.input, s, tt.want)
+		}
+	}
+
+	for _, input := range []string{"FY202", "FY2024 Q5", "q2 fy24 q3", "start of fiscal month"} {
+		if got, err := StrToTime(input, Rel(base), InTZ(time.UTC)); err == nil {
+			t.Errorf("%q = %s, want an error", input, got)
+		}
+	}
+}
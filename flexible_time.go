@@ -0,0 +1,78 @@
+package strtotime
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// FlexibleTime is a time.Time that is read from configuration files and
+// other text as StrToTime reads its input, so that a field can hold
+// "2024-06-01", "next monday" or "+90 days" alike. It implements
+// encoding.TextUnmarshaler, which the TOML decoders and gopkg.in/yaml.v3
+// use, the UnmarshalYAML method of gopkg.in/yaml.v2, and json.Unmarshaler,
+// which also takes numbers as Unix timestamps.
+//
+// Relative expressions are resolved when the value is decoded, with the
+// options set by SetDefaultOptions. An empty string decodes to the zero
+// time.
+type FlexibleTime struct {
+	time.Time
+}
+
+// UnmarshalText parses text as StrToTime does.
+func (ft *FlexibleTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		ft.Time = time.Time{}
+		return nil
+	}
+	t, err := StrToTime(string(text))
+	if err != nil {
+		return err
+	}
+	ft.Time = t
+	return nil
+}
+
+// MarshalText writes the time in RFC 3339 format, with its fraction of a
+// second if any, which UnmarshalText reads back.
+func (ft FlexibleTime) MarshalText() ([]byte, error) {
+	if ft.IsZero() {
+		return []byte{}, nil
+	}
+	return ft.Time.MarshalText()
+}
+
+// UnmarshalYAML decodes a YAML scalar for gopkg.in/yaml.v2 and, through its
+// support for that interface, gopkg.in/yaml.v3.
+func (ft *FlexibleTime) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return ft.UnmarshalText([]byte(s))
+}
+
+// UnmarshalJSON decodes a JSON string as UnmarshalText does, and a number
+// as a Unix timestamp. Null leaves the time unchanged.
+func (ft *FlexibleTime) UnmarshalJSON(data []byte) error {
+	switch {
+	case string(data) == "null":
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return ft.UnmarshalText([]byte(s))
+	}
+	return ft.UnmarshalText(append([]byte{'@'}, data...))
+}
+
+// MarshalJSON writes the time as a JSON string, as MarshalText does.
+func (ft FlexibleTime) MarshalJSON() ([]byte, error) {
+	text, err := ft.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
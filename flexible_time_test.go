@@ -0,0 +1,60 @@
+package strtotime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFlexibleTime(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	SetDefaultOptions(Rel(base), InTZ(time.UTC))
+	defer SetDefaultOptions()
+
+	var cfg struct {
+		Start     FlexibleTime `json:"start"`
+		Retention FlexibleTime `json:"retention"`
+		Stamp     FlexibleTime `json:"stamp"`
+		Empty     FlexibleTime `json:"empty"`
+		Null      FlexibleTime `json:"null"`
+	}
+	data := `{"start": "next monday", "retention": "+90 days", "stamp": 1709287200, "empty": "", "null": null}`
+	if err := json.Unmarshal([]byte(data), &cfg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	for name, tt := range map[string]struct{ got, want time.Time }{
+		"start":     {cfg.Start.Time, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		"retention": {cfg.Retention.Time, time.Date(2024, 5, 30, 10, 0, 0, 0, time.UTC)},
+		"stamp":     {cfg.Stamp.Time, base},
+		"empty":     {cfg.Empty.Time, time.Time{}},
+		"null":      {cfg.Null.Time, time.Time{}},
+	} {
+		if !tt.got.Equal(tt.want) {
+			t.Errorf("%s = %v, want %v", name, tt.got, tt.want)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"start": "not a date"}`), &cfg); err == nil {
+		t.Error("Unmarshal accepted an invalid date")
+	}
+
+	out, err := json.Marshal(cfg.Start)
+	if err != nil || string(out) != `"2024-03-04T00:00:00Z"` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+	var back FlexibleTime
+	if err := json.Unmarshal(out, &back); err != nil || !back.Equal(cfg.Start.Time) {
+		t.Errorf("round trip = %v, %v", back, err)
+	}
+
+	// gopkg.in/yaml.v2 and v3 call UnmarshalYAML with a function decoding
+	// the node into the value it is given.
+	var ft FlexibleTime
+	err = ft.UnmarshalYAML(func(v any) error {
+		*v.(*string) = "tomorrow noon"
+		return nil
+	})
+	if want := time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC); err != nil || !ft.Equal(want) {
+		t.Errorf("UnmarshalYAML = %v, %v, want %v", ft, err, want)
+	}
+}
@@ -0,0 +1,309 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// layoutKind is what one element of a layout matches. Layouts are the
+// compiled form of the format strings of other languages (see
+// ParseJavaFormat and ParseStrftime), matched against the value one element
+// at a time.
+type layoutKind int
+
+const (
+	layoutLiteral   layoutKind = iota // text, matched case-insensitively
+	layoutSpace                       // any run of blanks, possibly empty
+	layoutYear                        // year, four digits or more
+	layoutYear2                       // two-digit year
+	layoutMonth                       // 1-12
+	layoutMonthName                   // full or abbreviated month name
+	layoutDay                         // 1-31
+	layoutYearDay                     // 1-366
+	layoutWeekday                     // full or abbreviated day name, not used
+	layoutNumber                      // number that is not used (day of week)
+	layoutHour                        // 0-23
+	layoutHour24                      // 1-24, 24 being midnight
+	layoutHour12                      // 1-12
+	layoutHour11                      // 0-11
+	layoutMinute                      // 0-59
+	layoutSecond                      // 0-59
+	layoutFraction                    // digits after the decimal point
+	layoutMeridian                    // "am" or "pm"
+	layoutEra                         // "ad" or "bc"
+	layoutOffset                      // "Z", "+hh", "+hhmm" or "+hh:mm"
+	layoutZone                        // zone abbreviation, name or offset
+	layoutEpoch                       // seconds since the Unix epoch
+)
+
+// layoutElem is one element of a layout. Numeric elements read between
+// min and max digits; they read exactly min digits when the next element
+// is numeric too, as nothing else separates them.
+type layoutElem struct {
+	kind     layoutKind
+	min, max int
+	text     string // layoutLiteral
+}
+
+// numeric reports whether e reads digits.
+func (e layoutElem) numeric() bool {
+	switch e.kind {
+	case layoutYear, layoutYear2, layoutMonth, layoutDay, layoutYearDay, layoutNumber,
+		layoutHour, layoutHour24, layoutHour12, layoutHour11, layoutMinute, layoutSecond,
+		layoutFraction, layoutEpoch:
+		return true
+	}
+	return false
+}
+
+// layoutValues collects the fields read from a value.
+type layoutValues struct {
+	year, month, day, yearDay   int
+	hour, minute, second, nanos int
+	hourKind                    layoutKind
+	pm, bc, twoYear             bool
+	loc                         *time.Location
+	epoch                       int64
+	hasEpoch                    bool
+}
+
+// parseLayout matches value against layout and returns the time it
+// describes. Date fields that are not in the layout default to January 1st,
+// 1970, and clock fields to zero, as with Java's SimpleDateFormat. Values
+// without a zone are read in loc.
+func parseLayout(layout []layoutElem, value string, loc *time.Location) (time.Time, error) {
+	v := layoutValues{year: 1970, month: 1, day: 1, loc: loc}
+	i := 0
+	for k, e := range layout {
+		adjacent := k+1 < len(layout) && layout[k+1].numeric()
+		n, err := v.read(e, value[i:], adjacent)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w %q: at %q: %w", ErrInvalidDateFormat, value, value[i:], err)
+		}
+		i += n
+	}
+	if i < len(value) {
+		return time.Time{}, fmt.Errorf("%w %q: %w", ErrInvalidDateFormat, value, newTrailingTextError(value[i:]))
+	}
+	return v.time()
+}
+
+// read reads the element e at the start of s and returns the number of
+// bytes it took.
+func (v *layoutValues) read(e layoutElem, s string, adjacent bool) (int, error) {
+	switch e.kind {
+	case layoutLiteral:
+		if len(s) < len(e.text) || !strings.EqualFold(s[:len(e.text)], e.text) {
+			return 0, fmt.Errorf("expected %q", e.text)
+		}
+		return len(e.text), nil
+	case layoutSpace:
+		return len(s) - len(trimLeftSpace(s)), nil
+	case layoutMonthName, layoutWeekday, layoutMeridian, layoutEra:
+		return v.readName(e.kind, s)
+	case layoutOffset:
+		loc, n, ok := parseNumericTimezoneOffset(s)
+		if !ok {
+			return 0, fmt.Errorf("expected a UTC offset")
+		}
+		v.loc = loc
+		return n, nil
+	case layoutZone:
+		return v.readZone(s)
+	case layoutEpoch:
+		j := 0
+		if j < len(s) && (s[j] == '-' || s[j] == '+') {
+			j++
+		}
+		n, digits := readLayoutDigits(s[j:], 1, 18)
+		if digits == 0 {
+			return 0, fmt.Errorf("expected a Unix timestamp")
+		}
+		v.epoch, v.hasEpoch = int64(n), true
+		if s[0] == '-' {
+			v.epoch = -v.epoch
+		}
+		return j + digits, nil
+	}
+
+	hi := e.max
+	if adjacent {
+		hi = e.min
+	}
+	n, digits := readLayoutDigits(s, 1, hi)
+	if digits == 0 || (adjacent && digits < e.min) {
+		return 0, fmt.Errorf("expected a number")
+	}
+	switch e.kind {
+	case layoutYear:
+		v.year = n
+	case layoutYear2:
+		// Other than two digits, the year is taken as written.
+		v.year, v.twoYear = n, digits == 2
+	case layoutMonth:
+		v.month = n
+	case layoutDay:
+		v.day = n
+	case layoutYearDay:
+		v.yearDay = n
+	case layoutHour, layoutHour24, layoutHour12, layoutHour11:
+		v.hour, v.hourKind = n, e.kind
+	case layoutMinute:
+		v.minute = n
+	case layoutSecond:
+		v.second = n
+	case layoutFraction:
+		frac := s[:min(digits, 9)]
+		v.nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+	}
+	return digits, nil
+}
+
+// readLayoutDigits reads up to hi digits at the start of s and returns
+// their value and count, or a count of 0 when there are fewer than lo.
+func readLayoutDigits(s string, lo, hi int) (int, int) {
+	j := 0
+	for j < len(s) && j < hi && isDigit(s[j]) {
+		j++
+	}
+	if j < lo {
+		return 0, 0
+	}
+	return digitsValue(s[:j]), j
+}
+
+// readName reads a month or day name, a meridian or an era.
+func (v *layoutValues) readName(kind layoutKind, s string) (int, error) {
+	j := 0
+	for j < len(s) && s[j]|0x20 >= 'a' && s[j]|0x20 <= 'z' {
+		j++
+	}
+	word := strings.ToLower(s[:j])
+	switch kind {
+	case layoutMonthName:
+		m, ok := getMonthByName(word)
+		if !ok {
+			return 0, fmt.Errorf("expected a month name")
+		}
+		v.month = int(m)
+	case layoutWeekday:
+		if getDayOfWeek(word) < 0 {
+			return 0, fmt.Errorf("expected a day name")
+		}
+	case layoutMeridian:
+		switch word {
+		case "am":
+			v.pm = false
+		case "pm":
+			v.pm = true
+		default:
+			return 0, fmt.Errorf("expected AM or PM")
+		}
+	case layoutEra:
+		switch word {
+		case "ad", "ce":
+			v.bc = false
+		case "bc", "bce":
+			v.bc = true
+		default:
+			return 0, fmt.Errorf("expected AD or BC")
+		}
+	}
+	return j, nil
+}
+
+// readZone reads a zone abbreviation ("PST"), name ("Eastern Time"),
+// identifier ("America/New_York"), UTC offset ("-08:00") or GMT-relative
+// offset ("GMT-08:00"). Names of up to three words are read, the longest
+// known one first.
+func (v *layoutValues) readZone(s string) (int, error) {
+	if loc, n, ok := parseNumericTimezoneOffset(s); ok {
+		v.loc = loc
+		return n, nil
+	}
+	var ends [3]int
+	words, j := 0, 0
+	for words < len(ends) {
+		k := j
+		for k < len(s) && (s[k]|0x20 >= 'a' && s[k]|0x20 <= 'z' || s[k] == '/' || s[k] == '_') {
+			k++
+		}
+		if k == j {
+			break
+		}
+		ends[words] = k
+		words++
+		if k+1 >= len(s) || s[k] != ' ' {
+			break
+		}
+		j = k + 1
+	}
+	if words == 0 {
+		return 0, fmt.Errorf("expected a time zone")
+	}
+
+	name := strings.ToLower(s[:ends[0]])
+	if (name == "gmt" || name == "utc") && ends[0] < len(s) && (s[ends[0]] == '+' || s[ends[0]] == '-') {
+		if loc, n, ok := parseNumericTimezoneOffset(s[ends[0]:]); ok {
+			v.loc = loc
+			return ends[0] + n, nil
+		}
+	}
+	for w := words - 1; w >= 0; w-- {
+		if loc, ok := tryParseTimezone(s[:ends[w]]); ok {
+			v.loc = loc
+			return ends[w], nil
+		}
+	}
+	return 0, fmt.Errorf("expected a time zone")
+}
+
+// time checks the fields read and assembles them.
+func (v *layoutValues) time() (time.Time, error) {
+	if v.hasEpoch {
+		return time.Unix(v.epoch, 0).In(v.loc), nil
+	}
+
+	year := v.year
+	if v.twoYear {
+		year = parseTwoDigitYear(year)
+	}
+	if v.bc {
+		// There is no year 0: 1 BC is year 0 of the proleptic calendar.
+		year = 1 - year
+	}
+
+	hour := v.hour
+	switch v.hourKind {
+	case layoutHour24:
+		if hour < 1 || hour > 24 {
+			return time.Time{}, NewInvalidTimeError(v.hour, v.minute, v.second)
+		}
+		hour %= 24
+	case layoutHour12, layoutHour11:
+		if (v.hourKind == layoutHour12 && (hour < 1 || hour > 12)) || (v.hourKind == layoutHour11 && hour > 11) {
+			return time.Time{}, NewInvalidTimeError(v.hour, v.minute, v.second)
+		}
+		hour %= 12
+		if v.pm {
+			hour += 12
+		}
+	}
+	if !IsValidTime(hour, v.minute, v.second) {
+		return time.Time{}, NewInvalidTimeError(v.hour, v.minute, v.second)
+	}
+
+	month, day := v.month, v.day
+	if v.yearDay > 0 {
+		if v.yearDay > 365 && (v.yearDay > 366 || !IsLeapYear(year)) {
+			return time.Time{}, fmt.Errorf("%w: day %d of %d", ErrInvalidDate, v.yearDay, year)
+		}
+		month, day = 1, v.yearDay
+	} else if !IsValidDate(year, month, day) {
+		return time.Time{}, NewInvalidDateError(year, month, day)
+	}
+	return time.Date(year, time.Month(month), day, hour, v.minute, v.second, v.nanos, v.loc), nil
+}
@@ -2,9 +2,11 @@ package strtotime
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // fixedZone creates a time.FixedZone with a PHP-style "+HH:MM" / "-HH:MM" name
@@ -22,6 +24,57 @@ func fixedZone(offsetSeconds int) *time.Location {
 	return time.FixedZone(name, offsetSeconds)
 }
 
+// addMonths adds n months to t following policy. Unless the policy says
+// otherwise, a day past the end of the target month rolls over into the next
+// one, like time.AddDate and PHP do.
+func addMonths(t time.Time, n int, policy MonthPolicy) time.Time {
+	y, m, d := t.Date()
+	if policy == MonthKeepEnd && d == daysInMonth(y, m) {
+		first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+		y, m, n = first.Year(), first.Month(), 0
+		d = daysInMonth(y, m)
+	}
+	if policy == MonthClamp {
+		first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+		y, m, n = first.Year(), first.Month(), 0
+		d = min(d, daysInMonth(y, m))
+	}
+	h, mi, s := t.Clock()
+	return time.Date(y, m+time.Month(n), d, h, mi, s, t.Nanosecond(), t.Location())
+}
+
+// monthOverflow returns an *InvalidDateError for the day that adding n
+// months to t lands on when policy is MonthError and the target month
+// doesn't have that day, and nil otherwise.
+func monthOverflow(t time.Time, n int, policy MonthPolicy) error {
+	if policy != MonthError || n == 0 {
+		return nil
+	}
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	if d > daysInMonth(first.Year(), first.Month()) {
+		return NewInvalidDateError(first.Year(), int(first.Month()), d)
+	}
+	return nil
+}
+
+// limitTimeT32 checks that t fits in a signed 32-bit Unix timestamp. When it
+// doesn't, it returns ErrOutOfRange, or with clamp the nearest end of the
+// range in the location of t.
+func limitTimeT32(t time.Time, clamp bool) (time.Time, error) {
+	sec := t.Unix()
+	if sec >= math.MinInt32 && sec <= math.MaxInt32 {
+		return t, nil
+	}
+	if !clamp {
+		return time.Time{}, fmt.Errorf("%w: %s is outside the 32-bit time_t range", ErrOutOfRange, t.Format(time.RFC3339))
+	}
+	if sec < math.MinInt32 {
+		return time.Unix(math.MinInt32, 0).In(t.Location()), nil
+	}
+	return time.Unix(math.MaxInt32, 0).In(t.Location()), nil
+}
+
 // applyTimeOffset applies a time unit offset to the given time.
 // It normalizes the unit string and handles all PHP-compatible time arithmetic
 // including DST-aware day/hour operations.
@@ -33,6 +86,8 @@ func applyTimeOffset(t time.Time, amount int, unit string) time.Time {
 		return addDaysPHP(t, amount)
 	case UnitWeek:
 		return addDaysPHP(t, amount*7)
+	case UnitFortnight:
+		return addDaysPHP(t, amount*14)
 	case UnitWeekDay:
 		return addWeekdays(t, amount)
 	case UnitMonth:
@@ -56,6 +111,141 @@ func applyTimeOffset(t time.Time, amount int, unit string) time.Time {
 	return t
 }
 
+// normalizeInput trims surrounding whitespace and lowercases str. The
+// lowercase copy is only made when str actually contains an upper-case or
+// non-ASCII byte, so numeric timestamps and already-lowercase input (the
+// common case for machine-generated strings) are returned without allocating.
+func normalizeInput(str string) string {
+	str = strings.TrimSpace(str)
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if (c >= 'A' && c <= 'Z') || c >= 0x80 {
+			return strings.ToLower(str)
+		}
+	}
+	return str
+}
+
+// collapseSpaces replaces every run of whitespace in str with a single
+// space, so that downstream parsers can split on ' ' alone. Tabs, newlines
+// and the non-ASCII spaces of unicodeSpaceAt count as whitespace. str is
+// returned as-is when it is already in that form.
+func collapseSpaces(str string) string {
+	clean := true
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if (isSpaceByte(c) && c != ' ') || (c == ' ' && i > 0 && str[i-1] == ' ') ||
+			(c >= 0x80 && unicodeSpaceAt(str, i) > 0) {
+			clean = false
+			break
+		}
+	}
+	if clean {
+		return str
+	}
+	var b strings.Builder
+	b.Grow(len(str))
+	space := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if isSpaceByte(c) {
+			space = true
+			continue
+		}
+		if c >= 0x80 {
+			if n := unicodeSpaceAt(str, i); n > 0 {
+				space = true
+				i += n - 1
+				continue
+			}
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// unicodeSpaceAt returns the length of the non-ASCII space starting at
+// str[i], or 0 if there is none there. The no-break space, the narrow
+// no-break space that ICU writes before "AM" and "PM", and the thin space
+// are recognized.
+func unicodeSpaceAt(str string, i int) int {
+	switch {
+	case strings.HasPrefix(str[i:], "\u00a0"):
+		return 2
+	case strings.HasPrefix(str[i:], "\u202f"), strings.HasPrefix(str[i:], "\u2009"):
+		return 3
+	}
+	return 0
+}
+
+// blankSpaces replaces tabs, newlines and the other ASCII whitespace bytes
+// of str with spaces. Unlike collapseSpaces, it keeps every byte at its
+// offset.
+func blankSpaces(str string) string {
+	for i := 0; i < len(str); i++ {
+		if isSpaceByte(str[i]) && str[i] != ' ' {
+			b := []byte(str)
+			for j := i; j < len(b); j++ {
+				if isSpaceByte(b[j]) {
+					b[j] = ' '
+				}
+			}
+			return string(b)
+		}
+	}
+	return str
+}
+
+// isSpaceByte reports whether c is ASCII whitespace.
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// fieldBufLen is the number of fields format parsers make room for when
+// splitting their input with appendFields: inputs with more fields than
+// that are not common enough to be worth the stack space.
+const fieldBufLen = 8
+
+// appendFields appends the fields of s, split around runs of whitespace as
+// strings.Fields does, to dst and returns the extended slice. Format parsers
+// pass it a slice made with fieldBufLen room that doesn't escape, so that
+// splitting does not allocate.
+func appendFields(dst []string, s string) []string {
+	n := len(dst)
+	start := -1
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= utf8.RuneSelf:
+			// Non-ASCII input may hold Unicode spaces.
+			return append(dst[:n], strings.Fields(s)...)
+		case isSpaceByte(c):
+			if start >= 0 {
+				dst = append(dst, s[start:i])
+				start = -1
+			}
+		case start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		dst = append(dst, s[start:])
+	}
+	return dst
+}
+
+// trimLeftSpace strips leading ASCII whitespace from s.
+func trimLeftSpace(s string) string {
+	for len(s) > 0 && isSpaceByte(s[0]) {
+		s = s[1:]
+	}
+	return s
+}
+
 // fixDSTGap adjusts a time that fell into a DST spring-forward gap.
 // When time.Date produces a result on the wrong day (Go falls backward),
 // this shifts forward to match PHP's behavior (which falls forward).
@@ -73,6 +263,33 @@ func fixDSTGap(t time.Time, wantYear int, wantMonth time.Month, wantDay int) tim
 	return t // give up after 4 hours
 }
 
+// addDays adds n days to t following policy.
+func addDays(t time.Time, n int, policy DayPolicy) time.Time {
+	if policy == ElapsedDays {
+		return t.Add(time.Duration(n) * 24 * time.Hour)
+	}
+	return addDaysPHP(t, n)
+}
+
+// midnightAfter returns the start in loc of the day n days after the one of
+// t, such as tomorrow for n = 1. On days where a DST change skips midnight,
+// the day starts at the end of the gap, as in PHP.
+func midnightAfter(t time.Time, n int, policy DayPolicy, loc *time.Location) time.Time {
+	if policy == ElapsedDays {
+		t = t.Add(time.Duration(n) * 24 * time.Hour)
+	} else if n != 0 {
+		t = t.AddDate(0, 0, n)
+	}
+	y, m, d := t.Date()
+	return fixDSTGap(time.Date(y, m, d, 0, 0, 0, 0, loc), y, m, d)
+}
+
+// daysSinceWeekStart returns how many days d comes after start, the first
+// day of the week: 0 for start itself, 6 for the day before it.
+func daysSinceWeekStart(d, start time.Weekday) int {
+	return (int(d) - int(start) + 7) % 7
+}
+
 // addDaysPHP adds N calendar days using PHP-compatible DST handling.
 // It preserves wall-clock time (like Go's AddDate) but when the result
 // falls in a DST gap (non-existent local time), it shifts forward past
@@ -117,8 +334,21 @@ func addWeekdays(t time.Time, n int) time.Time {
 		n = -n
 	}
 
+	// Whole weeks are five weekdays, once a weekend start has been moved to
+	// the weekday it borders on the side being counted from.
 	result := t
-	for i := 0; i < n; i++ {
+	switch {
+	case step > 0 && t.Weekday() == time.Saturday:
+		result = t.AddDate(0, 0, -1)
+	case step > 0 && t.Weekday() == time.Sunday:
+		result = t.AddDate(0, 0, -2)
+	case step < 0 && t.Weekday() == time.Saturday:
+		result = t.AddDate(0, 0, 2)
+	case step < 0 && t.Weekday() == time.Sunday:
+		result = t.AddDate(0, 0, 1)
+	}
+	result = result.AddDate(0, 0, step*7*(n/5))
+	for i := 0; i < n%5; i++ {
 		result = result.AddDate(0, 0, step)
 		// Skip weekends
 		for result.Weekday() == time.Saturday || result.Weekday() == time.Sunday {
@@ -0,0 +1,97 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalizeInput(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"  @1700000000 ", "@1700000000"},
+		{"2024-01-15", "2024-01-15"},
+		{"Next Monday", "next monday"},
+		{"\t15 JAN 2024\n", "15 jan 2024"},
+		{"MÄRZ", "märz"},
+	}
+	for _, tt := range tests {
+		if got := normalizeInput(tt.input); got != tt.want {
+			t.Errorf("normalizeInput(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"@1700000000", "2024-01-15 10:30:00", " next monday "} {
+		if n := testing.AllocsPerRun(100, func() { _ = normalizeInput(s) }); n != 0 {
+			t.Errorf("normalizeInput(%q) allocated %v times, want 0", s, n)
+		}
+	}
+}
+
+func TestSplitDigitFields(t *testing.T) {
+	tests := []struct {
+		input   string
+		sep     byte
+		a, b, c string
+		ok      bool
+	}{
+		{"2024-01-15", '-', "2024", "01", "15", true},
+		{"15.01.24", '.', "15", "01", "24", true},
+		{"2024--15", '-', "", "", "", false},
+		{"2024-01-15-1", '-', "", "", "", false},
+		{"2024-01", '-', "", "", "", false},
+		{"2024-0a-15", '-', "", "", "", false},
+		{"1234567890123456789-01-01", '-', "", "", "", false},
+	}
+	for _, tt := range tests {
+		a, b, c, ok := splitDigitFields(tt.input, tt.sep)
+		if a != tt.a || b != tt.b || c != tt.c || ok != tt.ok {
+			t.Errorf("splitDigitFields(%q) = %q, %q, %q, %v", tt.input, a, b, c, ok)
+		}
+	}
+	if v := digitsValue("0930"); v != 930 {
+		t.Errorf("digitsValue(0930) = %d, want 930", v)
+	}
+}
+
+func TestCollapseSpaces(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"next monday", "next monday"},
+		{"next  monday", "next monday"},
+		{"2010-10-06\t12:53:10", "2010-10-06 12:53:10"},
+		{"+1 \n week", "+1 week"},
+	}
+	for _, tt := range tests {
+		if got := collapseSpaces(tt.input); got != tt.want {
+			t.Errorf("collapseSpaces(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestAddWeekdays(t *testing.T) {
+	fri := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	sat, sun := fri.AddDate(0, 0, 1), fri.AddDate(0, 0, 2)
+	tests := []struct {
+		from time.Time
+		n    int
+		want time.Time
+	}{
+		{fri, 1, time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{fri, 5, time.Date(2024, 3, 8, 10, 0, 0, 0, time.UTC)},
+		{fri, -5, time.Date(2024, 2, 23, 10, 0, 0, 0, time.UTC)},
+		{fri, 23, time.Date(2024, 4, 3, 10, 0, 0, 0, time.UTC)},
+		{sat, 1, time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{sat, 5, time.Date(2024, 3, 8, 10, 0, 0, 0, time.UTC)},
+		{sun, -1, fri},
+		{sun, -5, time.Date(2024, 2, 26, 10, 0, 0, 0, time.UTC)},
+		{sun, 0, time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{fri, 260, time.Date(2025, 2, 28, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := addWeekdays(tt.from, tt.n); !got.Equal(tt.want) {
+			t.Errorf("addWeekdays(%v, %d) = %v, want %v", tt.from.Weekday(), tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// HolidayCalendar provides the holidays that can be named in expressions
+// such as "christmas", "easter 2025" or "next thanksgiving".
+type HolidayCalendar interface {
+	// Holidays returns the holidays falling in year.
+	Holidays(year int) []Holiday
+}
+
+// Holiday is a named day of the year.
+type Holiday struct {
+	// Names are the names the holiday goes by, in lowercase and without
+	// apostrophes or periods: "christmas", "christmas day", "new years day".
+	Names []string
+	Month time.Month
+	Day   int
+	// WorkingDay is set for days that have a name but are not days off,
+	// such as Valentine's Day or Halloween. Business days don't skip them.
+	WorkingDay bool
+}
+
+// holidayRule is a holiday of the built-in calendars, whose date is
+// computed for each year.
+type holidayRule struct {
+	names   []string
+	date    func(year int) (time.Month, int)
+	working bool
+}
+
+// ruleCalendar is a HolidayCalendar made of holidayRules.
+type ruleCalendar []holidayRule
+
+func (c ruleCalendar) Holidays(year int) []Holiday {
+	hs := make([]Holiday, len(c))
+	for i, r := range c {
+		m, d := r.date(year)
+		hs[i] = Holiday{Names: r.names, Month: m, Day: d, WorkingDay: r.working}
+	}
+	return hs
+}
+
+// WesternHolidays holds the holidays shared by most Western countries:
+// New Year's Day, Valentine's Day, Easter and the feasts around it,
+// Halloween, Christmas and others. Only New Year's Day, Good Friday, Easter,
+// Easter Monday and Christmas are days off.
+var WesternHolidays HolidayCalendar = ruleCalendar{
+	{[]string{"new years day", "new year"}, fixedDate(time.January, 1), false},
+	{[]string{"epiphany"}, fixedDate(time.January, 6), true},
+	{[]string{"valentines day", "valentines", "st valentines day"}, fixedDate(time.February, 14), true},
+	{[]string{"st patricks day", "saint patricks day"}, fixedDate(time.March, 17), true},
+	{[]string{"ash wednesday"}, easterOffset(-46), true},
+	{[]string{"palm sunday"}, easterOffset(-7), true},
+	{[]string{"good friday"}, easterOffset(-2), false},
+	{[]string{"easter", "easter sunday"}, easterOffset(0), false},
+	{[]string{"easter monday"}, easterOffset(1), false},
+	{[]string{"ascension day", "ascension"}, easterOffset(39), true},
+	{[]string{"pentecost", "whit sunday", "whitsun"}, easterOffset(49), true},
+	{[]string{"halloween"}, fixedDate(time.October, 31), true},
+	{[]string{"all saints day"}, fixedDate(time.November, 1), true},
+	{[]string{"christmas eve"}, fixedDate(time.December, 24), true},
+	{[]string{"christmas", "christmas day", "xmas"}, fixedDate(time.December, 25), false},
+	{[]string{"new years eve"}, fixedDate(time.December, 31), true},
+}
+
+// USHolidays holds the United States federal holidays and a few other
+// widely observed days. Dates are those of the holidays themselves, not of
+// the days off observed when they fall on a weekend.
+var USHolidays HolidayCalendar = ruleCalendar{
+	{[]string{"martin luther king day", "mlk day"}, nthWeekdayDate(time.January, 3, time.Monday), false},
+	{[]string{"presidents day", "washingtons birthday"}, nthWeekdayDate(time.February, 3, time.Monday), false},
+	{[]string{"mothers day"}, nthWeekdayDate(time.May, 2, time.Sunday), true},
+	{[]string{"memorial day"}, nthWeekdayDate(time.May, -1, time.Monday), false},
+	{[]string{"fathers day"}, nthWeekdayDate(time.June, 3, time.Sunday), true},
+	{[]string{"juneteenth"}, fixedDate(time.June, 19), false},
+	{[]string{"independence day", "fourth of july"}, fixedDate(time.July, 4), false},
+	{[]string{"labor day"}, nthWeekdayDate(time.September, 1, time.Monday), false},
+	{[]string{"columbus day", "indigenous peoples day"}, nthWeekdayDate(time.October, 2, time.Monday), false},
+	{[]string{"veterans day"}, fixedDate(time.November, 11), false},
+	{[]string{"thanksgiving", "thanksgiving day"}, nthWeekdayDate(time.November, 4, time.Thursday), false},
+	{[]string{"black friday"}, func(year int) (time.Month, int) {
+		m, d := nthWeekdayDate(time.November, 4, time.Thursday)(year)
+		return m, d + 1
+	}, true},
+}
+
+// UKHolidays holds the bank holidays of England and Wales and a few other
+// widely observed days. As with USHolidays, substitute days are not
+// included.
+var UKHolidays HolidayCalendar = ruleCalendar{
+	{[]string{"mothering sunday"}, easterOffset(-21), true},
+	{[]string{"early may bank holiday", "may day"}, nthWeekdayDate(time.May, 1, time.Monday), false},
+	{[]string{"spring bank holiday"}, nthWeekdayDate(time.May, -1, time.Monday), false},
+	{[]string{"summer bank holiday"}, nthWeekdayDate(time.August, -1, time.Monday), false},
+	{[]string{"bonfire night", "guy fawkes night"}, fixedDate(time.November, 5), true},
+	{[]string{"remembrance sunday"}, nthWeekdayDate(time.November, 2, time.Sunday), true},
+	{[]string{"boxing day"}, fixedDate(time.December, 26), false},
+}
+
+// holidayCalendars are the calendars searched for holiday names, in order.
+var holidayCalendars = []HolidayCalendar{WesternHolidays, USHolidays, UKHolidays}
+
+// RegisterHolidayCalendar adds cal to the calendars searched for holiday
+// names. Calendars are searched from the most recently registered one to the
+// built-in ones, so a registered holiday hides a built-in one of the same
+// name.
+//
+// Like RegisterMonthName, this is not guarded against concurrent use:
+// register calendars from an init function, before any parsing takes place.
+func RegisterHolidayCalendar(cal HolidayCalendar) {
+	holidayCalendars = append([]HolidayCalendar{cal}, holidayCalendars...)
+}
+
+// fixedDate returns a holiday date function for a holiday falling on the
+// same day every year.
+func fixedDate(m time.Month, d int) func(int) (time.Month, int) {
+	return func(int) (time.Month, int) { return m, d }
+}
+
+// nthWeekdayDate returns a holiday date function for a holiday falling on
+// the nth wd of month m, or on the last one when n is -1.
+func nthWeekdayDate(m time.Month, n int, wd time.Weekday) func(int) (time.Month, int) {
+	return func(year int) (time.Month, int) {
+		if n < 0 {
+			last := time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC)
+			return m, last.Day() - (int(last.Weekday())-int(wd)+7)%7
+		}
+		first := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		return m, 1 + (int(wd)-int(first.Weekday())+7)%7 + 7*(n-1)
+	}
+}
+
+// easterOffset returns a holiday date function for a holiday falling days
+// after Easter Sunday, or before it when days is negative.
+func easterOffset(days int) func(int) (time.Month, int) {
+	return func(year int) (time.Month, int) {
+		m, d := easterSunday(year)
+		t := time.Date(year, m, d+days, 0, 0, 0, 0, time.UTC)
+		return t.Month(), t.Day()
+	}
+}
+
+// easterSunday returns the date of Western (Gregorian) Easter Sunday in
+// year, using the anonymous Gregorian algorithm.
+func easterSunday(year int) (time.Month, int) {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Month(month), day
+}
+
+// findHoliday returns the date of the holiday called by the words of name in
+// year, from the first calendar that knows it.
+func findHoliday(name []string, year int) (time.Month, int, bool) {
+	for _, cal := range holidayCalendars {
+		if rc, ok := cal.(ruleCalendar); ok {
+			// Only the date of the holiday named is computed.
+			for _, r := range rc {
+				for _, n := range r.names {
+					if isWords(n, name) {
+						m, d := r.date(year)
+						return m, d, true
+					}
+				}
+			}
+			continue
+		}
+		for _, h := range cal.Holidays(year) {
+			for _, n := range h.Names {
+				if isWords(n, name) {
+					return h.Month, h.Day, true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// isWords reports whether s is words joined by single spaces.
+func isWords(s string, words []string) bool {
+	for i, w := range words {
+		if i > 0 {
+			if s == "" || s[0] != ' ' {
+				return false
+			}
+			s = s[1:]
+		}
+		if !strings.HasPrefix(s, w) {
+			return false
+		}
+		s = s[len(w):]
+	}
+	return s == ""
+}
+
+// isHolidayWord reports whether word is part of a holiday name, which lets
+// digit-free inputs such as "christmas" through implausibleInput.
+func isHolidayWord(word string) bool {
+	for _, cal := range holidayCalendars {
+		for _, h := range cal.Holidays(2000) {
+			for _, n := range h.Names {
+				for _, w := range strings.Fields(n) {
+					if w == word {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// holidayPunct removes the punctuation holiday names are written without.
+var holidayPunct = strings.NewReplacer("'", "", ".", "")
+
+// parseHoliday parses holiday names: "christmas", "easter 2025", "next
+// thanksgiving", "last boxing day". A bare or "this" holiday is the one of
+// the current year; "next" is the first one after today and "last" the most
+// recent one before it. The result is midnight on that day.
+func parseHoliday(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), holidayPunct.Replace(str))
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+	now = now.In(loc)
+	year := now.Year()
+	shift := 0
+	switch fields[0] {
+	case DirectionNext:
+		shift, fields = 1, fields[1:]
+	case DirectionLast:
+		shift, fields = -1, fields[1:]
+	case "this":
+		fields = fields[1:]
+	default:
+		if n := len(fields); n > 1 && len(fields[n-1]) == 4 && isAllDigits(fields[n-1]) {
+			year, fields = digitsValue(fields[n-1]), fields[:n-1]
+		}
+	}
+	m, d, ok := findHoliday(fields, year)
+	if !ok {
+		return time.Time{}, false
+	}
+	t := time.Date(year, m, d, 0, 0, 0, 0, loc)
+	y, tm, td := now.Date()
+	today := time.Date(y, tm, td, 0, 0, 0, 0, loc)
+	if (shift > 0 && !t.After(today)) || (shift < 0 && !t.Before(today)) {
+		year += shift
+		if m, d, ok = findHoliday(fields, year); !ok {
+			return time.Time{}, false
+		}
+		t = time.Date(year, m, d, 0, 0, 0, 0, loc)
+	}
+	return fixDSTGap(t, year, m, d), true
+}
+
+func parseHolidayInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseHoliday(str, now, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(0, 0, 0)
+	pd.setMaterialized(t)
+	return true
+}
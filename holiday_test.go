@@ -0,0 +1,98 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidays(t *testing.T) {
+	base := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC) // a Friday
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"christmas", "2024-12-25"},
+		{"Christmas Day 2023", "2023-12-25"},
+		{"next christmas", "2024-12-25"},
+		{"last christmas", "2023-12-25"},
+		{"easter 2025", "2025-04-20"},
+		{"easter 2024", "2024-03-31"},
+		{"good friday 2024", "2024-03-29"},
+		{"easter monday 2000", "2000-04-24"},
+		{"next easter", "2024-03-31"},
+		{"last easter", "2023-04-09"},
+		{"thanksgiving", "2024-11-28"},
+		{"Thanksgiving 2025", "2025-11-27"},
+		{"memorial day 2024", "2024-05-27"},
+		{"labor day 2024", "2024-09-02"},
+		{"Boxing Day 2024", "2024-12-26"},
+		{"summer bank holiday 2024", "2024-08-26"},
+		{"New Year's Day 2025", "2025-01-01"},
+		{"St. Patrick's Day", "2024-03-17"},
+		{"this halloween", "2024-10-31"},
+	}
+	for _, tt := range tests {
+		got, err := StrToTime(tt.input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("%q: %v", tt.input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != tt.want+" 00:00:00" {
+			t.Errorf("%q = %s, want %s 00:00:00", tt.input, s, tt.want)
+		}
+	}
+
+	for _, input := range []string{"christmas 20245", "groundhog day"} {
+		if got, err := StrToTime(input, Rel(base), InTZ(time.UTC)); err == nil {
+			t.Errorf("%q = %s, want an error", input, got)
+		}
+	}
+}
+
+func TestEasterSunday(t *testing.T) {
+	dates := map[int]string{
+		1961: "04-02", 2008: "03-23", 2011: "04-24", 2019: "04-21",
+		2038: "04-25", 2285: "03-22",
+	}
+	for year, want := range dates {
+		m, d := easterSunday(year)
+		if got := time.Date(year, m, d, 0, 0, 0, 0, time.UTC).Format("01-02"); got != want {
+			t.Errorf("easterSunday(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+type testCalendar struct{}
+
+func (testCalendar) Holidays(year int) []Holiday {
+	return []Holiday{
+		{Names: []string{"company day"}, Month: time.September, Day: 14},
+		{Names: []string{"christmas"}, Month: time.January, Day: 7},
+	}
+}
+
+func TestRegisterHolidayCalendar(t *testing.T) {
+	saved := holidayCalendars
+	defer func() { holidayCalendars = saved }()
+	RegisterHolidayCalendar(testCalendar{})
+
+	base := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	for input, want := range map[string]string{
+		"company day":      "2024-09-14",
+		"last company day": "2023-09-14",
+		"christmas":        "2024-01-07",
+		"boxing day":       "2024-12-26",
+	} {
+		got, err := StrToTime(input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02"); s != want {
+			t.Errorf("%q = %s, want %s", input, s, want)
+		}
+	}
+}
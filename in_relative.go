@@ -0,0 +1,54 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseInRelative parses offsets into the future introduced by "in": "in 5
+// minutes", "in an hour", "in 2 weeks and 3 days". The amounts are numbers,
+// or "a" and "an" for one; pairs may be separated by "and" or commas.
+func parseInRelative(str string) (*Relative, bool) {
+	rest, ok := strings.CutPrefix(str, "in ")
+	if !ok {
+		return nil, false
+	}
+	fields := appendFields(make([]string, 0, fieldBufLen), strings.ReplaceAll(rest, ",", " "))
+	rel := &Relative{}
+	for i := 0; i < len(fields); {
+		if fields[i] == "and" && i > 0 {
+			i++
+			continue
+		}
+		if i+1 >= len(fields) {
+			return nil, false
+		}
+		amount := fields[i]
+		if amount == "a" || amount == "an" {
+			amount = "1"
+		}
+		if !isAllDigits(amount) || len(amount) > 9 || !addRelativeTerm(rel, amount+" "+fields[i+1]) {
+			return nil, false
+		}
+		i += 2
+	}
+	return rel, len(fields) > 0 && fields[len(fields)-1] != "and"
+}
+
+func parseInRelativeInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	rel, ok := parseInRelative(str)
+	if !ok {
+		return false
+	}
+	t, err := applyRelative(now, rel, loc, pd.cfg)
+	if err != nil {
+		pd.cause = err
+		return false
+	}
+	pd.Relative = rel
+	pd.setMaterialized(t)
+	pd.relativeApplied = true
+	return true
+}
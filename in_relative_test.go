@@ -0,0 +1,39 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInRelative(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"in 5 minutes":          time.Date(2024, 3, 1, 10, 5, 0, 0, time.UTC),
+		"in an hour":            time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC),
+		"in a week":             time.Date(2024, 3, 8, 10, 0, 0, 0, time.UTC),
+		"in 2 weeks and 3 days": time.Date(2024, 3, 18, 10, 0, 0, 0, time.UTC),
+		"in 1 hour, 30 minutes": time.Date(2024, 3, 1, 11, 30, 0, 0, time.UTC),
+		"in 1 year 2 months":    time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+		"In 90 Secs":            time.Date(2024, 3, 1, 10, 1, 30, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{
+		"in 2024",
+		"in march",
+		"in 3 days and",
+		"in -3 days",
+		"in monday",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
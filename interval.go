@@ -0,0 +1,183 @@
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Period is an ISO 8601 duration such as "P1Y2M10DT2H30M" or "P3W". The
+// calendar components are kept apart from the clock ones, as months and years
+// have no fixed length: a period only becomes a length of time once it is
+// applied to a date.
+type Period struct {
+	Years, Months, Weeks, Days int
+	Hours, Minutes, Seconds    int
+	Nanoseconds                int
+}
+
+// ISOInterval is an ISO 8601 time interval.
+type ISOInterval struct {
+	Start, End time.Time
+
+	// Period is the duration the interval was written with, or nil when it
+	// was given as a start and an end.
+	Period *Period
+}
+
+// ParseInterval parses an ISO 8601 time interval written as "start/end",
+// "start/period" or "period/end", such as "2023-01-01/2023-02-01",
+// "2023-01-01/P1M" or "P1M/2023-02-01". The ends are read as StrToTime reads
+// its input, with the same options. The end that is not given is found by
+// applying the period to the other one, following the MonthArithmetic and
+// DayArithmetic options. Intervals whose end comes before their start are
+// rejected.
+func ParseInterval(str string, opts ...Option) (ISOInterval, error) {
+	first, second, ok := strings.Cut(strings.TrimSpace(str), "/")
+	if !ok || strings.Contains(second, "/") {
+		return ISOInterval{}, fmt.Errorf("%w %q: expected two parts separated by /", ErrInvalidInterval, str)
+	}
+	first, second = strings.TrimSpace(first), strings.TrimSpace(second)
+	cfg := resolveSettings(opts)
+
+	var iv ISOInterval
+	startPeriod, startIsPeriod, err := readPeriod(first)
+	if err != nil {
+		return ISOInterval{}, fmt.Errorf("%w %q: %w", ErrInvalidInterval, str, err)
+	}
+	endPeriod, endIsPeriod, err := readPeriod(second)
+	if err != nil {
+		return ISOInterval{}, fmt.Errorf("%w %q: %w", ErrInvalidInterval, str, err)
+	}
+	switch {
+	case startIsPeriod && endIsPeriod:
+		return ISOInterval{}, fmt.Errorf("%w %q: both parts are durations", ErrInvalidInterval, str)
+	case startIsPeriod:
+		end, err := StrToTime(second, opts...)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: end: %w", ErrInvalidInterval, str, err)
+		}
+		start, err := startPeriod.shift(end, -1, cfg)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: start: %w", ErrInvalidInterval, str, err)
+		}
+		iv = ISOInterval{Start: start, End: end, Period: &startPeriod}
+	case endIsPeriod:
+		start, err := StrToTime(first, opts...)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: start: %w", ErrInvalidInterval, str, err)
+		}
+		end, err := endPeriod.shift(start, 1, cfg)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: end: %w", ErrInvalidInterval, str, err)
+		}
+		iv = ISOInterval{Start: start, End: end, Period: &endPeriod}
+	default:
+		start, err := StrToTime(first, opts...)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: start: %w", ErrInvalidInterval, str, err)
+		}
+		end, err := StrToTime(second, opts...)
+		if err != nil {
+			return ISOInterval{}, fmt.Errorf("%w %q: end: %w", ErrInvalidInterval, str, err)
+		}
+		iv = ISOInterval{Start: start, End: end}
+	}
+
+	if iv.End.Before(iv.Start) {
+		return ISOInterval{}, fmt.Errorf("%w %q: end is before start", ErrInvalidInterval, str)
+	}
+	return iv, nil
+}
+
+// readPeriod reads s as a duration if it looks like one: a "P" on its own or
+// followed by a digit or a "T". Such a part that is not a valid duration is an error
+// rather than a date.
+func readPeriod(s string) (Period, bool, error) {
+	if s == "" || (s[0] != 'P' && s[0] != 'p') || (len(s) > 1 && !isDigit(s[1]) && s[1] != 'T' && s[1] != 't') {
+		return Period{}, false, nil
+	}
+	p, ok := parsePeriod(s)
+	if !ok {
+		return Period{}, false, fmt.Errorf("malformed duration %q", s)
+	}
+	return p, true, nil
+}
+
+// parsePeriod parses an ISO 8601 duration, "PnYnMnWnDTnHnMnS" with at least
+// one component and the components in that order. Only the seconds may have
+// a fraction, of up to nine digits.
+func parsePeriod(s string) (Period, bool) {
+	if len(s) < 3 || (s[0] != 'P' && s[0] != 'p') {
+		return Period{}, false
+	}
+	var p Period
+	units, fields := "ymwd", []*int{&p.Years, &p.Months, &p.Weeks, &p.Days}
+	rank, n := -1, 0
+	for i := 1; i < len(s); {
+		if s[i] == 'T' || s[i] == 't' {
+			if units == "hms" {
+				return Period{}, false
+			}
+			units, fields = "hms", []*int{&p.Hours, &p.Minutes, &p.Seconds}
+			rank, n = -1, 0
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(s) && isDigit(s[j]) {
+			j++
+		}
+		if j == i || j-i > 9 {
+			return Period{}, false
+		}
+		value := digitsValue(s[i:j])
+		nanos, hasFraction := 0, false
+		if j < len(s) && (s[j] == '.' || s[j] == ',') {
+			k := j + 1
+			for k < len(s) && isDigit(s[k]) {
+				k++
+			}
+			if k == j+1 || k-j-1 > 9 {
+				return Period{}, false
+			}
+			nanos, hasFraction = digitsValue(s[j+1:k]+strings.Repeat("0", 9-(k-j-1))), true
+			j = k
+		}
+		if j == len(s) {
+			return Period{}, false
+		}
+
+		r := strings.IndexByte(units, s[j]|0x20)
+		if r <= rank || (hasFraction && (units != "hms" || r != 2)) {
+			return Period{}, false
+		}
+		*fields[r] = value
+		if hasFraction {
+			p.Nanoseconds = nanos
+		}
+		rank = r
+		n++
+		i = j + 1
+	}
+	// Each part, and the time part after a "T", needs a component.
+	return p, n > 0
+}
+
+// shift applies p to t, forwards for a sign of 1 and backwards for -1. It
+// fails only when the MonthError policy rejects the month arithmetic.
+func (p Period) shift(t time.Time, sign int, cfg parseSettings) (time.Time, error) {
+	if months := 12*p.Years + p.Months; months != 0 {
+		if err := monthOverflow(t, sign*months, cfg.months); err != nil {
+			return time.Time{}, err
+		}
+		t = addMonths(t, sign*months, cfg.months)
+	}
+	if days := 7*p.Weeks + p.Days; days != 0 {
+		t = addDays(t, sign*days, cfg.days)
+	}
+	clock := time.Duration(p.Hours)*time.Hour + time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second + time.Duration(p.Nanoseconds)
+	return t.Add(time.Duration(sign) * clock), nil
+}
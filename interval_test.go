@@ -0,0 +1,53 @@
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	day := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		input      string
+		start, end time.Time
+		period     *Period
+	}{
+		{"2023-01-01/2023-02-01", day(2023, 1, 1), day(2023, 2, 1), nil},
+		{"2023-01-01/P1M", day(2023, 1, 1), day(2023, 2, 1), &Period{Months: 1}},
+		{"P1M/2023-02-01", day(2023, 1, 1), day(2023, 2, 1), &Period{Months: 1}},
+		{"2023-01-01T00:00:00Z/P1Y2M10DT2H30M", day(2023, 1, 1), time.Date(2024, 3, 11, 2, 30, 0, 0, time.UTC),
+			&Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}},
+		{"2023-01-01/P2W", day(2023, 1, 1), day(2023, 1, 15), &Period{Weeks: 2}},
+		{"2023-01-01/PT0.5S", day(2023, 1, 1), day(2023, 1, 1).Add(500 * time.Millisecond), &Period{Nanoseconds: 5e8}},
+	}
+	for _, tt := range tests {
+		got, err := ParseInterval(tt.input, InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("ParseInterval(%q) error: %v", tt.input, err)
+			continue
+		}
+		if !got.Start.Equal(tt.start) || !got.End.Equal(tt.end) {
+			t.Errorf("ParseInterval(%q) = %v/%v, want %v/%v", tt.input, got.Start, got.End, tt.start, tt.end)
+		}
+		if (got.Period == nil) != (tt.period == nil) || (got.Period != nil && *got.Period != *tt.period) {
+			t.Errorf("ParseInterval(%q) period = %+v, want %+v", tt.input, got.Period, tt.period)
+		}
+	}
+
+	for _, input := range []string{
+		"2023-01-01",
+		"P1M/P1D",
+		"2023-02-01/2023-01-01",
+		"2023-01-01/P",
+		"2023-01-01/P1DT",
+		"2023-01-01/P1D2M",
+		"2023-01-01/P1.5D",
+		"2023-01-01/bogus",
+		"2023-01-01/2023-02-01/2023-03-01",
+	} {
+		if _, err := ParseInterval(input, InTZ(time.UTC)); !errors.Is(err, ErrInvalidInterval) {
+			t.Errorf("ParseInterval(%q) error = %v, want ErrInvalidInterval", input, err)
+		}
+	}
+}
@@ -56,8 +56,8 @@ func parseISO8601DateTime(str string, loc *time.Location) (time.Time, bool) {
 	} else if len(datePart) >= 8 && isAllDigits(datePart) {
 		// YYYYMMDD compact format
 		year, _ = strconv.Atoi(datePart[:len(datePart)-4])
-		month, _ = strconv.Atoi(datePart[len(datePart)-4 : len(datePart)-2])
-		day, _ = strconv.Atoi(datePart[len(datePart)-2:])
+		month = digitsValue(datePart[len(datePart)-4 : len(datePart)-2])
+		day = digitsValue(datePart[len(datePart)-2:])
 		if !IsValidDate(year, month, day) {
 			return time.Time{}, false
 		}
@@ -118,22 +118,22 @@ func parseISO8601Time(s string) (int, int, int, int, int, bool) {
 		consumed = flexN
 	} else if len(s) >= 6 && isAllDigits(s[:6]) {
 		// HHMMSS
-		hour, _ = strconv.Atoi(s[:2])
-		minute, _ = strconv.Atoi(s[2:4])
-		second, _ = strconv.Atoi(s[4:6])
+		hour = digitsValue(s[:2])
+		minute = digitsValue(s[2:4])
+		second = digitsValue(s[4:6])
 		consumed = 6
 	} else if len(s) >= 4 && isAllDigits(s[:4]) {
 		// HHMM
-		hour, _ = strconv.Atoi(s[:2])
-		minute, _ = strconv.Atoi(s[2:4])
+		hour = digitsValue(s[:2])
+		minute = digitsValue(s[2:4])
 		consumed = 4
 	} else if len(s) >= 2 && isAllDigits(s[:2]) && (len(s) == 2 || (s[2] < '0' || s[2] > '9')) {
 		// HH (hour only, no minute - e.g., "2012-02-02T10")
-		hour, _ = strconv.Atoi(s[:2])
+		hour = digitsValue(s[:2])
 		consumed = 2
 	} else if len(s) >= 1 && s[0] >= '0' && s[0] <= '9' && (len(s) == 1 || (s[1] < '0' || s[1] > '9')) {
 		// H (single-digit hour)
-		hour, _ = strconv.Atoi(s[:1])
+		hour = digitsValue(s[:1])
 		consumed = 1
 	} else {
 		return 0, 0, 0, 0, 0, false
@@ -146,8 +146,9 @@ func parseISO8601Time(s string) (int, int, int, int, int, bool) {
 		return 0, 0, 0, 0, 0, false
 	}
 
-	// Handle fractional seconds
-	if consumed < len(s) && s[consumed] == '.' {
+	// Handle fractional seconds, with ISO 8601's decimal comma as well
+	// ("10:30:45,5")
+	if consumed < len(s) && (s[consumed] == '.' || s[consumed] == ',' && consumed+1 < len(s) && isDigit(s[consumed+1])) {
 		consumed++
 		fracStart := consumed
 		for consumed < len(s) && s[consumed] >= '0' && s[consumed] <= '9' {
@@ -156,13 +157,13 @@ func parseISO8601Time(s string) (int, int, int, int, int, bool) {
 		if consumed > fracStart {
 			fracStr := s[fracStart:consumed]
 			// Pad or truncate to 9 digits (nanoseconds)
-			for len(fracStr) < 9 {
-				fracStr += "0"
-			}
 			if len(fracStr) > 9 {
 				fracStr = fracStr[:9]
 			}
-			nanos, _ = strconv.Atoi(fracStr)
+			nanos = digitsValue(fracStr)
+			for i := len(fracStr); i < 9; i++ {
+				nanos *= 10
+			}
 		}
 	}
 
@@ -184,7 +185,7 @@ func parseFlexTime(s string) (int, int, int, int, bool) {
 	if pos >= len(s) || s[pos] != ':' {
 		return 0, 0, 0, 0, false
 	}
-	hour, _ := strconv.Atoi(s[hStart:pos])
+	hour := digitsValue(s[hStart:pos])
 	pos++ // skip ':'
 
 	// Parse minute (1-2 digits)
@@ -195,7 +196,7 @@ func parseFlexTime(s string) (int, int, int, int, bool) {
 	if pos == mStart || pos-mStart > 2 {
 		return 0, 0, 0, 0, false
 	}
-	minute, _ := strconv.Atoi(s[mStart:pos])
+	minute := digitsValue(s[mStart:pos])
 
 	second := 0
 	// Optional seconds
@@ -208,7 +209,7 @@ func parseFlexTime(s string) (int, int, int, int, bool) {
 		if pos == sStart || pos-sStart > 2 {
 			return 0, 0, 0, 0, false
 		}
-		second, _ = strconv.Atoi(s[sStart:pos])
+		second = digitsValue(s[sStart:pos])
 	}
 
 	return hour, minute, second, pos, true
@@ -242,8 +243,8 @@ func parseNumericTimezoneOffset(s string) (*time.Location, int, bool) {
 
 	// Try +HH:MM or -HH:MM
 	if len(rest) >= 5 && rest[2] == ':' && isAllDigits(rest[:2]) && isAllDigits(rest[3:5]) {
-		h, _ := strconv.Atoi(rest[:2])
-		m, _ := strconv.Atoi(rest[3:5])
+		h := digitsValue(rest[:2])
+		m := digitsValue(rest[3:5])
 		if h <= 14 && m <= 59 {
 			offset := sign * (h*3600 + m*60)
 			return fixedZone(offset), 6, true
@@ -253,7 +254,7 @@ func parseNumericTimezoneOffset(s string) (*time.Location, int, bool) {
 	// Try +H:MM or -H:MM (single-digit hour).
 	if len(rest) >= 4 && rest[1] == ':' && rest[0] >= '0' && rest[0] <= '9' && isAllDigits(rest[2:4]) {
 		h := int(rest[0] - '0')
-		m, _ := strconv.Atoi(rest[2:4])
+		m := digitsValue(rest[2:4])
 		if h <= 9 && m <= 59 {
 			offset := sign * (h*3600 + m*60)
 			return fixedZone(offset), 5, true
@@ -263,7 +264,7 @@ func parseNumericTimezoneOffset(s string) (*time.Location, int, bool) {
 	// Try +HH:M or -HH:M (shortened single-digit minute, bug74173)
 	if len(rest) >= 4 && rest[2] == ':' && isAllDigits(rest[:2]) && rest[3] >= '0' && rest[3] <= '9' &&
 		(len(rest) == 4 || rest[4] < '0' || rest[4] > '9') {
-		h, _ := strconv.Atoi(rest[:2])
+		h := digitsValue(rest[:2])
 		m := int(rest[3] - '0')
 		if h <= 14 && m <= 59 {
 			offset := sign * (h*3600 + m*60)
@@ -273,8 +274,8 @@ func parseNumericTimezoneOffset(s string) (*time.Location, int, bool) {
 
 	// Try +HHMM or -HHMM
 	if len(rest) >= 4 && isAllDigits(rest[:4]) {
-		h, _ := strconv.Atoi(rest[:2])
-		m, _ := strconv.Atoi(rest[2:4])
+		h := digitsValue(rest[:2])
+		m := digitsValue(rest[2:4])
 		if h <= 14 && m <= 59 {
 			offset := sign * (h*3600 + m*60)
 			return fixedZone(offset), 5, true
@@ -285,7 +286,7 @@ func parseNumericTimezoneOffset(s string) (*time.Location, int, bool) {
 	if len(rest) >= 2 && isAllDigits(rest[:2]) {
 		// Make sure there's nothing else after (or only non-digit)
 		if len(rest) == 2 || rest[2] < '0' || rest[2] > '9' {
-			h, _ := strconv.Atoi(rest[:2])
+			h := digitsValue(rest[:2])
 			if h <= 14 {
 				offset := sign * h * 3600
 				return fixedZone(offset), 3, true
@@ -343,21 +344,20 @@ func parseISOWeekDate(str string, loc *time.Location) (time.Time, bool) {
 	// In compact form (no dash before W), week is always 2 digits
 	compact := wIdx > 0 && str[wIdx-1] != '-'
 	rest := str[wIdx+1:]
-	weekStr := ""
 	i := 0
 	maxWeekDigits := 2
 	if !compact {
 		maxWeekDigits = 2 // extended form also allows 1-2 digits
 	}
 	for i < len(rest) && i < maxWeekDigits && rest[i] >= '0' && rest[i] <= '9' {
-		weekStr += string(rest[i])
 		i++
 	}
+	weekStr := rest[:i]
 	if len(weekStr) == 0 || len(weekStr) < 2 {
 		// PHP requires 2-digit week numbers
 		return time.Time{}, false
 	}
-	week, _ := strconv.Atoi(weekStr)
+	week := digitsValue(weekStr)
 	if week < 1 || week > 53 {
 		return time.Time{}, false
 	}
@@ -413,3 +413,32 @@ func parseISOWeekDate(str string, loc *time.Location) (time.Time, bool) {
 
 	return target, true
 }
+
+// cutISODateTime splits a week or ordinal date followed by a time of day,
+// "2008-W27-1 10:30", "2008-193T10:30:00", returning the date and the time.
+func cutISODateTime(str string) (date string, hour, minute, second, nanos int, ok bool) {
+	if len(str) == 0 || !isDigit(str[0]) {
+		return "", 0, 0, 0, 0, false
+	}
+	i := 0
+	for i < len(str) && (isDigit(str[i]) || str[i] == '-' || str[i] == 'w') {
+		i++
+	}
+	if i == len(str) || (str[i] != ' ' && str[i] != 't') {
+		return "", 0, 0, 0, 0, false
+	}
+	clock := strings.TrimLeft(str[i+1:], " ")
+	hour, minute, second, nanos, n, ok := parseISO8601Time(clock)
+	if !ok || n != len(clock) || hour == 24 {
+		return "", 0, 0, 0, 0, false
+	}
+	return str[:i], hour, minute, second, nanos, true
+}
+
+// setDateTimeOf sets the time of day of the date parsed into pd.
+func setDateTimeOf(pd *ParsedDate, hour, minute, second, nanos int) {
+	pd.SetTime(hour, minute, second)
+	pd.SetFraction(float64(nanos) / 1e9)
+	t := pd.materialized
+	pd.setMaterialized(time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, nanos, t.Location()))
+}
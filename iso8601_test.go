@@ -0,0 +1,51 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestISO8601DateTime(t *testing.T) {
+	plus2 := time.FixedZone("", 2*3600)
+	tests := map[string]time.Time{
+		"2023-01-15T10:30:45Z":                time.Date(2023, 1, 15, 10, 30, 45, 0, time.UTC),
+		"2023-01-15T10:30:45+02:00":           time.Date(2023, 1, 15, 10, 30, 45, 0, plus2),
+		"2023-01-15 10:30:45+02:00":           time.Date(2023, 1, 15, 10, 30, 45, 0, plus2),
+		"2023-01-15T10:30:45+0200":            time.Date(2023, 1, 15, 10, 30, 45, 0, plus2),
+		"2023-01-15T10:30:45.123456Z":         time.Date(2023, 1, 15, 10, 30, 45, 123456000, time.UTC),
+		"2023-01-15T10:30:45,5Z":              time.Date(2023, 1, 15, 10, 30, 45, 500000000, time.UTC),
+		"2023-01-15 10:30:45,25":              time.Date(2023, 1, 15, 10, 30, 45, 250000000, time.UTC),
+		"2023-01-15T10:30:45.123456789-05:30": time.Date(2023, 1, 15, 10, 30, 45, 123456789, time.FixedZone("", -(5*3600+30*60))),
+		"20230115T103045Z":                    time.Date(2023, 1, 15, 10, 30, 45, 0, time.UTC),
+		"2023-01-15t10:30z":                   time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
+
+func TestISOOrdinalDate(t *testing.T) {
+	tests := map[string]time.Time{
+		"2008-193":          time.Date(2008, 7, 11, 0, 0, 0, 0, time.UTC),
+		"2008193":           time.Date(2008, 7, 11, 0, 0, 0, 0, time.UTC),
+		"2008-366":          time.Date(2008, 12, 31, 0, 0, 0, 0, time.UTC),
+		"2007-366":          time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC),
+		"2008-193 10:30":    time.Date(2008, 7, 11, 10, 30, 0, 0, time.UTC),
+		"2008-193T10:30:15": time.Date(2008, 7, 11, 10, 30, 15, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{"2008-367", "2008-400", "2007366", "2008-000 10:30"} {
+		if got, err := StrToTime(input, InTZ(time.UTC)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseJavaFormat parses value following pattern, a java.text.SimpleDateFormat
+// pattern such as "yyyy-MM-dd'T'HH:mm:ss.SSSXXX" or "EEE, d MMM yyyy hh:mm
+// a z", so that format strings from JVM services can be reused as they are.
+//
+// The letters G, y, M, L, d, D, E, u, a, H, k, K, h, m, s, S, z, Z and X are
+// supported, with text in single quotes taken literally and two quotes standing
+// for a quote. As with SimpleDateFormat, the number of letters only sets the
+// number of digits read when two numeric fields follow each other, three or
+// more M are a month name, and a "yy" year of two digits falls between 1970
+// and 2069, as in StrToTime. S is read as a fraction of a second, as in
+// java.time, where SimpleDateFormat counts milliseconds; the two agree for
+// "SSS". Fields missing from the pattern default to January 1st, 1970 at
+// midnight, in the location set with InTZ or the local one when the value
+// has no zone.
+func ParseJavaFormat(pattern, value string, opts ...Option) (time.Time, error) {
+	layout, err := javaLayout(pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+	_, loc := resolveOptions(withDefaults(opts))
+	return parseLayout(layout, value, loc)
+}
+
+// javaLayout compiles a SimpleDateFormat pattern.
+func javaLayout(pattern string) ([]layoutElem, error) {
+	var layout []layoutElem
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			layout = append(layout, layoutElem{kind: layoutLiteral, text: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if c == '\'' {
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				lit.WriteByte('\'')
+				i += 2
+				continue
+			}
+			end := i + 1
+			for {
+				j := strings.IndexByte(pattern[end:], '\'')
+				if j < 0 {
+					return nil, fmt.Errorf("%w %q: unterminated quote", ErrInvalidLayout, pattern)
+				}
+				lit.WriteString(pattern[end : end+j])
+				end += j + 1
+				if end < len(pattern) && pattern[end] == '\'' {
+					lit.WriteByte('\'')
+					end++
+					continue
+				}
+				break
+			}
+			i = end
+			continue
+		}
+		if c|0x20 < 'a' || c|0x20 > 'z' {
+			lit.WriteByte(c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		var e layoutElem
+		switch c {
+		case 'G':
+			e = layoutElem{kind: layoutEra}
+		case 'y':
+			e = layoutElem{kind: layoutYear, min: n, max: 9}
+			if n == 2 {
+				e = layoutElem{kind: layoutYear2, min: 2, max: 9}
+			}
+		case 'M', 'L':
+			e = layoutElem{kind: layoutMonth, min: n, max: 2}
+			if n >= 3 {
+				e = layoutElem{kind: layoutMonthName}
+			}
+		case 'd':
+			e = layoutElem{kind: layoutDay, min: n, max: 2}
+		case 'D':
+			e = layoutElem{kind: layoutYearDay, min: n, max: 3}
+		case 'E':
+			e = layoutElem{kind: layoutWeekday}
+		case 'u':
+			e = layoutElem{kind: layoutNumber, min: n, max: 1}
+		case 'a':
+			e = layoutElem{kind: layoutMeridian}
+		case 'H':
+			e = layoutElem{kind: layoutHour, min: n, max: 2}
+		case 'k':
+			e = layoutElem{kind: layoutHour24, min: n, max: 2}
+		case 'K':
+			e = layoutElem{kind: layoutHour11, min: n, max: 2}
+		case 'h':
+			e = layoutElem{kind: layoutHour12, min: n, max: 2}
+		case 'm':
+			e = layoutElem{kind: layoutMinute, min: n, max: 2}
+		case 's':
+			e = layoutElem{kind: layoutSecond, min: n, max: 2}
+		case 'S':
+			e = layoutElem{kind: layoutFraction, min: n, max: 9}
+		case 'z', 'Z':
+			e = layoutElem{kind: layoutZone}
+		case 'X':
+			e = layoutElem{kind: layoutOffset}
+		default:
+			return nil, fmt.Errorf("%w %q: unsupported pattern letter %q", ErrInvalidLayout, pattern, c)
+		}
+		flush()
+		layout = append(layout, e)
+		i += n
+	}
+	flush()
+	return layout, nil
+}
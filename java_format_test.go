@@ -0,0 +1,60 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseJavaFormat(t *testing.T) {
+	pdt := time.FixedZone("", -7*3600)
+	tests := []struct {
+		pattern, value string
+		want           time.Time
+	}{
+		{"yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "2001-07-04T12:08:56.235-07:00", time.Date(2001, 7, 4, 12, 8, 56, 235000000, pdt)},
+		{"yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "2001-07-04T12:08:56.235Z", time.Date(2001, 7, 4, 12, 8, 56, 235000000, time.UTC)},
+		{"yyyy-MM-dd'T'HH:mm:ss.SSSZ", "2001-07-04T12:08:56.235-0700", time.Date(2001, 7, 4, 12, 8, 56, 235000000, pdt)},
+		{"yyyy.MM.dd G 'at' HH:mm:ss z", "2001.07.04 AD at 12:08:56 PDT", time.Date(2001, 7, 4, 12, 8, 56, 0, pdt)},
+		{"EEE, MMM d, ''yy", "Wed, Jul 4, '01", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"h:mm a", "12:08 PM", time.Date(1970, 1, 1, 12, 8, 0, 0, time.UTC)},
+		{"hh 'o''clock' a", "12 o'clock AM", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"K:mm a, z", "0:08 PM, GMT-07:00", time.Date(1970, 1, 1, 12, 8, 0, 0, pdt)},
+		{"yyyyy.MMMMM.dd GGG hh:mm aaa", "02001.July.04 AD 12:08 PM", time.Date(2001, 7, 4, 12, 8, 0, 0, time.UTC)},
+		{"yyMMddHHmmssZ", "010704120856-0700", time.Date(2001, 7, 4, 12, 8, 56, 0, pdt)},
+		{"EEE, d MMM yyyy HH:mm:ss Z", "Wed, 4 Jul 2001 12:08:56 -0700", time.Date(2001, 7, 4, 12, 8, 56, 0, pdt)},
+		{"dd/MM/yyyy kk:mm", "04/07/2001 24:00", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"yyyy-DDD", "2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"d/M/y", "4/7/2001", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"dd/MM/yy", "04/07/1901", time.Date(1901, 7, 4, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseJavaFormat(tt.pattern, tt.value, InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("ParseJavaFormat(%q, %q) error: %v", tt.pattern, tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) || got.Nanosecond() != tt.want.Nanosecond() {
+			t.Errorf("ParseJavaFormat(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		pattern, value string
+		want           error
+	}{
+		{"yyyy-MM-dd", "2023-02-30", ErrInvalidDate},
+		{"yyyy-MM-dd", "2023-02-03 extra", ErrInvalidDateFormat},
+		{"yyyy-MM-dd", "2023/02/03", ErrInvalidDateFormat},
+		{"HH:mm", "24:00", ErrInvalidTimeComponent},
+		{"hh:mm a", "13:00 PM", ErrInvalidTimeComponent},
+		{"YYYY-'W'ww", "2001-W27", ErrInvalidLayout},
+		{"yyyy-MM-dd'T", "2001-07-04T", ErrInvalidLayout},
+	} {
+		if _, err := ParseJavaFormat(tt.pattern, tt.value); !errors.Is(err, tt.want) {
+			t.Errorf("ParseJavaFormat(%q, %q) error = %v, want %v", tt.pattern, tt.value, err, tt.want)
+		}
+	}
+}
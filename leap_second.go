@@ -0,0 +1,37 @@
+package strtotime
+
+import "time"
+
+// leapSecondIndex returns the offset of the seconds field of the first
+// "h:mm:60" time in str, or -1 when there is none.
+func leapSecondIndex(str string) int {
+	for i := 5; i+1 < len(str); i++ {
+		if str[i] != '6' || str[i+1] != '0' || (i+2 < len(str) && isDigit(str[i+2])) {
+			continue
+		}
+		if str[i-1] == ':' && isDigit(str[i-2]) && isDigit(str[i-3]) &&
+			str[i-4] == ':' && isDigit(str[i-5]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseLeapSecond parses str, whose seconds field at offset i reads 60, as
+// the 59th second and then applies policy: LeapSecondRoll moves on to the
+// next second, LeapSecondClamp drops the fraction of a second.
+func parseLeapSecond(str string, i int, policy LeapSecondPolicy, opts []Option, pd *ParsedDate) (time.Time, error) {
+	t, err := parseToTime(str[:i]+"59"+str[i+2:], opts, pd)
+	if err != nil {
+		return t, err
+	}
+	if policy == LeapSecondRoll {
+		return t.Add(time.Second), nil
+	}
+	return t.Add(-time.Duration(t.Nanosecond())), nil
+}
@@ -0,0 +1,63 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLeapSeconds(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		clamp time.Time
+		roll  time.Time
+	}{
+		{"2016-12-31 23:59:60", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2016-12-31t23:59:60z", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2016-12-31T23:59:60.5+00:00", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 5e8, time.UTC)},
+		{"Dec 31 2016 23:59:60", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2015-07-01 01:59:60 +0200", time.Date(2015, 6, 30, 23, 59, 59, 0, time.UTC), time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"23:59:60", time.Date(2024, 3, 10, 23, 59, 59, 0, time.UTC), time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		for _, c := range []struct {
+			policy LeapSecondPolicy
+			want   time.Time
+		}{{LeapSecondClamp, tt.clamp}, {LeapSecondRoll, tt.roll}} {
+			got, err := StrToTime(tt.input, Rel(base), InTZ(time.UTC), LeapSeconds(c.policy))
+			if err != nil {
+				t.Errorf("StrToTime(%q) with policy %d error: %v", tt.input, c.policy, err)
+				continue
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("StrToTime(%q) with policy %d = %v, want %v", tt.input, c.policy, got, c.want)
+			}
+		}
+	}
+
+	for _, input := range []string{"2016-12-31 23:59:60", "23:59:60"} {
+		for _, opts := range [][]Option{{Rel(base)}, {Rel(base), LeapSeconds(LeapSecondReject)}} {
+			if _, err := StrToTime(input, opts...); err == nil {
+				t.Errorf("StrToTime(%q) should fail without LeapSeconds", input)
+			}
+		}
+	}
+}
+
+func TestLeapSecondIndex(t *testing.T) {
+	tests := map[string]int{
+		"23:59:60":            6,
+		"2016-12-31 23:59:60": 17,
+		"9:59:60.25":          5,
+		"23:59:59":            -1,
+		"23:59:600":           -1,
+		"+60 seconds":         -1,
+		"12:60":               -1,
+	}
+	for input, want := range tests {
+		if got := leapSecondIndex(input); got != want {
+			t.Errorf("leapSecondIndex(%q) = %d, want %d", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"bufio"
+	"io"
+	"time"
+)
+
+// lineParserMaxFields is the largest number of leading fields LineParser
+// considers when auto-detecting where the timestamp of a line ends.
+const lineParserMaxFields = 6
+
+// LineParser extracts the timestamp found at the start of each line of a log
+// file or similar stream. The options given to NewLineParser apply to every
+// line, and parser state is reused from one line to the next.
+//
+// A LineParser must not be used by several goroutines at once.
+type LineParser struct {
+	// Fields is the number of leading whitespace-separated fields that hold
+	// the timestamp, e.g. 1 for "2024-01-15T10:30:00Z message" or 3 for
+	// syslog's "Jan 15 10:30:00 host ...". When zero, the longest prefix of
+	// up to six fields that parses is used; the field count found for one
+	// line is tried first on the next.
+	Fields int
+
+	opts []Option
+	buf  ParserBuffer
+	last int // field count that matched the previous line
+}
+
+// NewLineParser returns a LineParser parsing timestamps with opts.
+func NewLineParser(opts ...Option) *LineParser {
+	return &LineParser{opts: opts}
+}
+
+// ParseLine parses the timestamp at the start of line and returns it along
+// with the remainder of the line, stripped of its leading whitespace.
+func (lp *LineParser) ParseLine(line string) (time.Time, string, error) {
+	var ends [lineParserMaxFields]int
+	n := fieldEnds(line, ends[:])
+	if n == 0 {
+		return time.Time{}, line, ErrEmptyTimeString
+	}
+
+	if lp.Fields > 0 {
+		k := min(lp.Fields, n)
+		t, err := lp.buf.StrToTime(line[:ends[k-1]], lp.opts...)
+		if err != nil {
+			return time.Time{}, line, err
+		}
+		return t, trimLeftSpace(line[ends[k-1]:]), nil
+	}
+
+	// Try the field count that matched the previous line first. It is only
+	// kept if one more field doesn't parse too, so that a line whose
+	// timestamp is longer than the previous one isn't cut short.
+	if k := lp.last; k > 0 && k <= n {
+		if t, err := lp.buf.StrToTime(line[:ends[k-1]], lp.opts...); err == nil {
+			if k == n {
+				return t, trimLeftSpace(line[ends[k-1]:]), nil
+			}
+			if _, err := lp.buf.StrToTime(line[:ends[k]], lp.opts...); err != nil {
+				return t, trimLeftSpace(line[ends[k-1]:]), nil
+			}
+		}
+	}
+
+	var lastErr error
+	for k := n; k > 0; k-- {
+		t, err := lp.buf.StrToTime(line[:ends[k-1]], lp.opts...)
+		if err == nil {
+			lp.last = k
+			return t, trimLeftSpace(line[ends[k-1]:]), nil
+		}
+		lastErr = err
+	}
+	return time.Time{}, line, lastErr
+}
+
+// Scan reads r line by line and calls fn with the timestamp and remainder of
+// each non-empty line, or with the parse error for lines without a
+// recognizable timestamp. Scanning stops at the first error returned by fn,
+// which Scan then returns, or at the first read error.
+func (lp *LineParser) Scan(r io.Reader, fn func(t time.Time, rest string, err error) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		if trimLeftSpace(line) == "" {
+			continue
+		}
+		t, rest, err := lp.ParseLine(line)
+		if err := fn(t, rest, err); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
+
+// fieldEnds records in ends the offset just past each of the first
+// len(ends) whitespace-separated fields of s, and returns how many it found.
+func fieldEnds(s string, ends []int) int {
+	n := 0
+	i := 0
+	for n < len(ends) {
+		for i < len(s) && isSpaceByte(s[i]) {
+			i++
+		}
+		if i == len(s) {
+			break
+		}
+		for i < len(s) && !isSpaceByte(s[i]) {
+			i++
+		}
+		ends[n] = i
+		n++
+	}
+	return n
+}
@@ -0,0 +1,63 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLineParser(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	log := `2024-01-15T10:30:00Z service started
+2024-01-15T10:31:02Z connection from 10.0.0.1
+
+Jan 15 10:32:00 host sshd[42]: accepted
+garbage line
+`
+	type entry struct {
+		t    time.Time
+		rest string
+		err  bool
+	}
+	var got []entry
+	lp := NewLineParser(Rel(base), InTZ(time.UTC))
+	err := lp.Scan(strings.NewReader(log), func(tm time.Time, rest string, err error) error {
+		got = append(got, entry{tm, rest, err != nil})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+
+	want := []entry{
+		{time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), "service started", false},
+		{time.Date(2024, 1, 15, 10, 31, 2, 0, time.UTC), "connection from 10.0.0.1", false},
+		{time.Date(2024, 1, 15, 10, 32, 0, 0, time.UTC), "host sshd[42]: accepted", false},
+		{time.Time{}, "garbage line", true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !got[i].t.Equal(want[i].t) || got[i].rest != want[i].rest || got[i].err != want[i].err {
+			t.Errorf("line %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLineParserFields(t *testing.T) {
+	lp := NewLineParser(InTZ(time.UTC))
+	lp.Fields = 2
+	tm, rest, err := lp.ParseLine("2024-01-15 10:30:00 today we deployed")
+	if err != nil {
+		t.Fatalf("ParseLine: %v", err)
+	}
+	if want := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC); !tm.Equal(want) {
+		t.Errorf("ParseLine = %v, want %v", tm, want)
+	}
+	if rest != "today we deployed" {
+		t.Errorf("rest = %q", rest)
+	}
+}
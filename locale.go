@@ -0,0 +1,337 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale translates the words of dates written in another language than
+// English, so that "15. März 2024", "lundi prochain" or "hace 3 días" can be
+// parsed. FrenchLocale, GermanLocale and SpanishLocale are built in; others
+// can be written as a WordLocale and registered with RegisterLocale, or
+// passed to WithLocale.
+type Locale interface {
+	// Word returns the English for word, given in lowercase: a month or
+	// weekday name ("january", "monday"), a unit ("day", "weeks"), an
+	// ordinal ("first") or a word of relative expressions ("next", "last",
+	// "this", "ago", "in", "today", "tomorrow", "yesterday", "now", "noon",
+	// "midnight"). It returns false when word is not one of the locale's,
+	// and "" for words to leave out, such as articles and prepositions.
+	Word(word string) (string, bool)
+}
+
+// WordLocale is a Locale given by a table from the words of a language, in
+// lowercase, to their English translation.
+type WordLocale map[string]string
+
+// Word returns the translation of word in l.
+func (l WordLocale) Word(word string) (string, bool) {
+	en, ok := l[word]
+	return en, ok
+}
+
+// locales are the locales HintLocale can select, by language subtag.
+var locales = map[string]Locale{
+	"de": GermanLocale,
+	"es": SpanishLocale,
+	"fr": FrenchLocale,
+}
+
+// RegisterLocale makes l the locale of the language tagged tag, such as
+// "it", so that HintLocale selects it for that language. Only the language
+// subtag is used: "pt-BR" registers l for "pt".
+//
+// Like RegisterCalendar, this is not guarded against concurrent use:
+// register locales from an init function, before any parsing takes place.
+func RegisterLocale(tag string, l Locale) {
+	locales[languageSubtag(tag)] = l
+}
+
+// languageSubtag returns the language of tag in lowercase: "fr" for "fr-CA".
+func languageSubtag(tag string) string {
+	lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	lang, _, _ = strings.Cut(lang, "_")
+	return lang
+}
+
+// WithLocale reads the words of l in inputs, in addition to English ones.
+// Words are translated one for one, so expressions follow the English
+// grammar, except that a "next" or "last" after the weekday or unit it
+// applies to ("lundi prochain") and an "ago" before its amount ("vor 3
+// Tagen") are moved where English puts them, and that the period of German
+// ordinal days ("15. März") is dropped. A word that is English where it
+// stands keeps its English meaning, so that "10 am" is still a time under
+// GermanLocale. Errors quote the input as written, not its translation.
+func WithLocale(l Locale) Option {
+	return localeOption{locale: l}
+}
+
+// localeOption is an internal type for the WithLocale option
+type localeOption struct {
+	locale Locale
+}
+
+func (localeOption) isOption() bool {
+	return true
+}
+
+// localeOf returns the locale set with WithLocale in opts, or nil.
+func localeOf(opts []Option) Locale {
+	var l Locale
+	for _, opt := range opts {
+		if o, ok := opt.(localeOption); ok {
+			l = o.locale
+		}
+	}
+	return l
+}
+
+// translateLocale returns str translated by the locale set with WithLocale,
+// if any.
+func translateLocale(str string, opts []Option) string {
+	l := localeOf(opts)
+	if l == nil {
+		return str
+	}
+	return translateWords(str, l)
+}
+
+// translateWords returns str with the words of l replaced by their English
+// translation, rearranged as described for WithLocale.
+func translateWords(str string, l Locale) string {
+	words, _ := translateFields(strings.Fields(str), l)
+	return strings.Join(words, " ")
+}
+
+// translateFields returns the words of fields translated by l and
+// rearranged, with the index in fields each of them comes from. Words
+// dropped by l have no entry.
+func translateFields(fields []string, l Locale) ([]string, []int) {
+	words := make([]string, 0, len(fields))
+	src := make([]int, 0, len(fields))
+	for i, f := range fields {
+		start := strings.IndexFunc(f, unicode.IsLetter)
+		if start < 0 {
+			words, src = append(words, f), append(src, i)
+			continue
+		}
+		end := strings.LastIndexFunc(f, unicode.IsLetter)
+		_, size := utf8.DecodeRuneInString(f[end:])
+		end += size
+		w := strings.ToLower(f[start:end])
+		if isEnglishAt(fields, i, w) {
+			words, src = append(words, f), append(src, i)
+			continue
+		}
+		en, ok := l.Word(w)
+		switch {
+		case !ok:
+			words, src = append(words, f), append(src, i)
+		case en != "":
+			words, src = append(words, f[:start]+en+f[end:]), append(src, i)
+		}
+	}
+
+	for i := 0; i < len(words); i++ {
+		switch w := words[i]; {
+		case (w == "next" || w == "last") && i > 0 && isWeekdayOrUnit(words[i-1]):
+			// "lundi prochain", "semana pasada"
+			words[i-1], words[i] = w, words[i-1]
+			src[i-1], src[i] = src[i], src[i-1]
+		case w == "ago" && i+2 < len(words) && isAllDigits(words[i+1]) && isWeekdayOrUnit(words[i+2]):
+			// "vor 3 tagen", "hace 3 días"
+			s := src[i]
+			copy(words[i:], words[i+1:i+3])
+			copy(src[i:], src[i+1:i+3])
+			words[i+2], src[i+2] = w, s
+			i += 2
+		case len(w) > 1 && len(w) <= 3 && w[len(w)-1] == '.' && isAllDigits(w[:len(w)-1]) &&
+			i+1 < len(words) && classifyWord(words[i+1])&kindMonth != 0:
+			// "15. märz"
+			words[i] = w[:len(w)-1]
+		}
+	}
+	return words, src
+}
+
+// isEnglishAt reports whether w, the letters of fields[i] in lowercase,
+// reads as an English word where it stands, in which case it is left as
+// is: the German "am" of "am Montag" is dropped, but not the one of "10 am".
+// Meridiems and timezone abbreviations are English after a number, other
+// English keywords anywhere.
+func isEnglishAt(fields []string, i int, w string) bool {
+	k := classifyWord(w)
+	if k&^kindTZ != 0 {
+		return true
+	}
+	if k == 0 && w != "am" && w != "pm" && w != "a.m" && w != "p.m" {
+		return false
+	}
+	if i == 0 {
+		return false
+	}
+	prev := fields[i-1]
+	return prev[len(prev)-1] >= '0' && prev[len(prev)-1] <= '9'
+}
+
+// localeError returns err with the input, token and position of a
+// *ParseError for the translation of str under the WithLocale option made
+// to refer to str itself, as the caller wrote it.
+func localeError(str string, opts []Option, err error) error {
+	l := localeOf(opts)
+	var pe *ParseError
+	if l == nil || !errors.As(err, &pe) {
+		return err
+	}
+	orig := collapseSpaces(normalizeInput(str))
+	fields := strings.Fields(orig)
+	words, src := translateFields(fields, l)
+	translated := strings.Join(words, " ")
+	if translated == orig {
+		return err
+	}
+	out := &ParseError{Input: orig, Token: orig, Err: pe.Err}
+	if pe.Input != translated || pe.Token == pe.Input {
+		return out
+	}
+
+	// Offsets of the fields in orig and of the words in the translation.
+	at := make([]int, len(fields))
+	for i, off := 0, 0; i < len(fields); i++ {
+		at[i] = off + strings.Index(orig[off:], fields[i])
+		off = at[i] + len(fields[i])
+	}
+	// origPos returns the offset in orig of offset p of the translation,
+	// the start of the word when the locale changed it, or its end when
+	// end is set.
+	origPos := func(p int, end bool) int {
+		off := 0
+		for k, w := range words {
+			f := fields[src[k]]
+			if p <= off+len(w) {
+				if w == f {
+					return at[src[k]] + p - off
+				}
+				if end {
+					return at[src[k]] + len(f)
+				}
+				return at[src[k]]
+			}
+			off += len(w) + 1
+		}
+		return len(orig)
+	}
+	from, to := origPos(pe.Pos, false), origPos(pe.Pos+len(pe.Token), true)
+	if from >= to {
+		return out
+	}
+	out.Token, out.Pos = orig[from:to], from
+	if pe.Err != nil {
+		msg := strings.Replace(pe.Err.Error(), strconv.Quote(pe.Token), strconv.Quote(out.Token), 1)
+		msg = strings.Replace(msg, fmt.Sprintf("at position %d", pe.Pos), fmt.Sprintf("at position %d", out.Pos), 1)
+		out.Err = &relocatedError{msg: msg, err: pe.Err}
+	}
+	return out
+}
+
+// relocatedError is an error whose message quotes the input as written
+// rather than its translation.
+type relocatedError struct {
+	msg string
+	err error
+}
+
+func (e *relocatedError) Error() string {
+	return e.msg
+}
+
+func (e *relocatedError) Unwrap() error {
+	return e.err
+}
+
+// isWeekdayOrUnit reports whether w is an English weekday name or time unit.
+func isWeekdayOrUnit(w string) bool {
+	return getDayOfWeek(w) >= 0 || classifyWord(w)&kindUnit != 0
+}
+
+// FrenchLocale reads dates written in French.
+var FrenchLocale = WordLocale{
+	"janvier": "january", "janv": "jan", "février": "february", "fevrier": "february", "févr": "feb",
+	"mars": "march", "avril": "april", "avr": "apr", "mai": "may", "juin": "june",
+	"juillet": "july", "juil": "jul", "août": "august", "aout": "august",
+	"septembre": "september", "sept": "sep", "octobre": "october", "novembre": "november",
+	"décembre": "december", "decembre": "december", "déc": "dec",
+
+	"lundi": "monday", "mardi": "tuesday", "mercredi": "wednesday", "jeudi": "thursday",
+	"vendredi": "friday", "samedi": "saturday", "dimanche": "sunday",
+
+	"seconde": "second", "secondes": "seconds", "minute": "minute", "minutes": "minutes",
+	"heure": "hour", "heures": "hours", "jour": "day", "jours": "days",
+	"semaine": "week", "semaines": "weeks", "mois": "months", "an": "year", "ans": "years",
+	"année": "year", "années": "years",
+
+	"premier": "first", "première": "first", "deuxième": "second", "troisième": "third",
+	"quatrième": "fourth", "dernier": "last", "dernière": "last",
+
+	"prochain": "next", "prochaine": "next", "ce": "this", "cette": "this",
+	"aujourd'hui": "today", "demain": "tomorrow", "hier": "yesterday",
+	"maintenant": "now", "midi": "noon", "minuit": "midnight",
+	"dans": "in", "à": "at", "le": "", "la": "", "de": "", "du": "",
+}
+
+// GermanLocale reads dates written in German.
+var GermanLocale = WordLocale{
+	"januar": "january", "jänner": "january", "februar": "february", "märz": "march",
+	"april": "april", "mai": "may", "juni": "june", "juli": "july", "august": "august",
+	"september": "september", "oktober": "october", "okt": "oct", "november": "november",
+	"dezember": "december", "dez": "dec",
+
+	"montag": "monday", "dienstag": "tuesday", "mittwoch": "wednesday", "donnerstag": "thursday",
+	"freitag": "friday", "samstag": "saturday", "sonnabend": "saturday", "sonntag": "sunday",
+
+	"sekunde": "second", "sekunden": "seconds", "minute": "minute", "minuten": "minutes",
+	"stunde": "hour", "stunden": "hours", "tag": "day", "tage": "days", "tagen": "days",
+	"woche": "week", "wochen": "weeks", "monat": "month", "monate": "months", "monaten": "months",
+	"jahr": "year", "jahre": "years", "jahren": "years",
+
+	"erster": "first", "erste": "first", "ersten": "first", "zweiter": "second", "zweite": "second",
+	"dritter": "third", "dritte": "third", "letzter": "last", "letzte": "last", "letzten": "last",
+
+	"nächster": "next", "nächste": "next", "nächsten": "next", "kommenden": "next",
+	"dieser": "this", "diese": "this", "diesen": "this",
+	"heute": "today", "morgen": "tomorrow", "gestern": "yesterday", "jetzt": "now",
+	"mittag": "noon", "mitternacht": "midnight",
+	"vor": "ago", "in": "in", "um": "at", "am": "", "der": "", "die": "", "den": "",
+}
+
+// SpanishLocale reads dates written in Spanish.
+var SpanishLocale = WordLocale{
+	"enero": "january", "febrero": "february", "marzo": "march", "abril": "april",
+	"mayo": "may", "junio": "june", "julio": "july", "agosto": "august",
+	"septiembre": "september", "setiembre": "september", "octubre": "october",
+	"noviembre": "november", "diciembre": "december",
+
+	"lunes": "monday", "martes": "tuesday", "miércoles": "wednesday", "miercoles": "wednesday",
+	"jueves": "thursday", "viernes": "friday", "sábado": "saturday", "sabado": "saturday",
+	"domingo": "sunday",
+
+	"segundo": "second", "segundos": "seconds", "minuto": "minute", "minutos": "minutes",
+	"hora": "hour", "horas": "hours", "día": "day", "dia": "day", "días": "days", "dias": "days",
+	"semana": "week", "semanas": "weeks", "mes": "month", "meses": "months",
+	"año": "year", "años": "years",
+
+	"primer": "first", "primero": "first", "primera": "first", "tercero": "third",
+	"último": "last", "última": "last", "ultimo": "last", "ultima": "last",
+
+	"próximo": "next", "próxima": "next", "proximo": "next", "proxima": "next",
+	"pasado": "last", "pasada": "last", "este": "this", "esta": "this",
+	"hoy": "today", "mañana": "tomorrow", "ayer": "yesterday", "ahora": "now",
+	"mediodía": "noon", "medianoche": "midnight",
+	"hace": "ago", "en": "in", "a": "at", "el": "", "la": "", "de": "", "del": "",
+}
@@ -0,0 +1,94 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithLocale(t *testing.T) {
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	tests := []struct {
+		input  string
+		locale Locale
+		want   string
+	}{
+		{"15 mars 2024", FrenchLocale, "2024-03-15 00:00:00"},
+		{"lundi prochain", FrenchLocale, "2024-03-04 00:00:00"},
+		{"le premier lundi de janvier 2025", FrenchLocale, "2025-01-06 00:00:00"},
+		{"dans 3 jours", FrenchLocale, "2024-03-04 10:00:00"},
+		{"demain à midi", FrenchLocale, "2024-03-02 12:00:00"},
+		{"15. März 2024", GermanLocale, "2024-03-15 00:00:00"},
+		{"Montag, 15. Januar 2024 um 10:30", GermanLocale, "2024-01-15 10:30:00"},
+		{"vor 3 Tagen", GermanLocale, "2024-02-27 10:00:00"},
+		{"nächsten Montag", GermanLocale, "2024-03-04 00:00:00"},
+		{"15 de marzo de 2024", SpanishLocale, "2024-03-15 00:00:00"},
+		{"hace 2 semanas", SpanishLocale, "2024-02-16 10:00:00"},
+		{"el próximo lunes", SpanishLocale, "2024-03-04 00:00:00"},
+		{"mañana", SpanishLocale, "2024-03-02 00:00:00"},
+		{"2024-03-15 10:00", SpanishLocale, "2024-03-15 10:00:00"},
+		{"next friday", GermanLocale, "2024-03-08 00:00:00"},
+		{"3 giorni fa", WordLocale{"giorni": "days", "fa": "ago"}, "2024-02-27 10:00:00"},
+		{"tomorrow 10 am", GermanLocale, "2024-03-02 10:00:00"},
+		{"morgen um 10 am", GermanLocale, "2024-03-02 10:00:00"},
+		{"am Montag", GermanLocale, "2024-03-04 00:00:00"},
+		{"mañana a mediodía", SpanishLocale, "2024-03-02 12:00:00"},
+	}
+	for _, tt := range tests {
+		got, err := StrToTime(tt.input, base, InTZ(time.UTC), WithLocale(tt.locale))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", tt.input, err)
+			continue
+		}
+		if s := got.Format("2006-01-02 15:04:05"); s != tt.want {
+			t.Errorf("StrToTime(%q) = %s, want %s", tt.input, s, tt.want)
+		}
+	}
+
+	if _, err := StrToTime("15 mars 2024", base); err == nil {
+		t.Error("StrToTime(\"15 mars 2024\") without a locale succeeded")
+	}
+}
+
+func TestLocaleErrorPosition(t *testing.T) {
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	tests := []struct {
+		input  string
+		locale Locale
+		token  string
+		pos    int
+	}{
+		{"morgen bogus", GermanLocale, "bogus", 7},
+		{"demain   à  xyz", FrenchLocale, "à", 7},
+	}
+	for _, tt := range tests {
+		_, err := StrToTime(tt.input, base, WithLocale(tt.locale))
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("StrToTime(%q) error = %v, want a *ParseError", tt.input, err)
+			continue
+		}
+		if pe.Token != tt.token || pe.Pos != tt.pos {
+			t.Errorf("StrToTime(%q) error at %q, %d, want %q, %d", tt.input, pe.Token, pe.Pos, tt.token, tt.pos)
+		}
+		if want := fmt.Sprintf("%q at position %d", tt.token, tt.pos); !strings.Contains(err.Error(), want) {
+			t.Errorf("StrToTime(%q) error = %q, want it to contain %s", tt.input, err, want)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	defer delete(locales, "it")
+	RegisterLocale("it-IT", WordLocale{"domani": "tomorrow"})
+	got, err := ParseAny("domani", HintLocale("it"), HintOptions(Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))))
+	if err != nil {
+		t.Fatalf("ParseAny(\"domani\") error: %v", err)
+	}
+	if want := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseAny(\"domani\") = %v, want %v", got, want)
+	}
+}
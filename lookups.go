@@ -1,6 +1,7 @@
 package strtotime
 
 import (
+	"fmt"
 	"strings"
 	"time"
 )
@@ -32,22 +33,71 @@ var monthNames = map[string]time.Month{
 	"dec":       time.December,
 }
 
+// RegisterMonthName adds name as an alias for month, so that it is
+// recognized anywhere a month name is accepted. Matching is case-insensitive.
+//
+// The lookup tables are not guarded against concurrent writes: register
+// aliases from an init function, before any parsing takes place.
+func RegisterMonthName(name string, month time.Month) error {
+	if month < time.January || month > time.December {
+		return fmt.Errorf("%w: month %d", ErrInvalidDateComponent, month)
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return ErrInvalidDateFormat
+	}
+	monthNames[name] = month
+	return nil
+}
+
+// lookupKeyMax is the longest name folded on the stack by foldKey. Longer
+// names are only possible through registration and are folded on the heap.
+const lookupKeyMax = 32
+
+// foldKey returns the ASCII-lowercased bytes of s, using buf as storage so
+// that lookups of short names do not allocate. Map indexing and switches on
+// string(key) are optimized by the compiler to not copy key.
+func foldKey(s string, buf *[lookupKeyMax]byte) []byte {
+	if len(s) > lookupKeyMax {
+		return []byte(strings.ToLower(s))
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		buf[i] = c
+	}
+	return buf[:len(s)]
+}
+
+// hasPrefixFold reports whether s begins with the lowercase ASCII prefix,
+// ignoring case.
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
 // getMonthByName returns the time.Month for a month name.
-// Input should be lowercase (StrToTime lowercases before calling parsers).
+// Matching is case-insensitive and does not allocate.
 // Handles trailing periods (e.g., "dec." → December).
 func getMonthByName(name string) (time.Month, bool) {
-	month, ok := monthNames[name]
+	var buf [lookupKeyMax]byte
+	key := foldKey(name, &buf)
+	month, ok := monthNames[string(key)]
 	if ok {
 		return month, true
 	}
-	month, ok = monthNames[strings.TrimSuffix(name, ".")]
+	if n := len(key); n > 0 && key[n-1] == '.' {
+		month, ok = monthNames[string(key[:n-1])]
+	}
 	return month, ok
 }
 
 // getDayOfWeek converts a day name to day number (0 = Sunday, 6 = Saturday).
-// Input should be lowercase. Returns -1 if the name is not recognized.
+// Matching is case-insensitive. Returns -1 if the name is not recognized.
 func getDayOfWeek(day string) int {
-	switch day {
+	var buf [lookupKeyMax]byte
+	switch string(foldKey(day, &buf)) {
 	case "sunday", "sun":
 		return 0
 	case "monday", "mon":
@@ -74,6 +124,8 @@ var unitMap = map[string]string{
 	// Week variations
 	"w": UnitWeek, "wk": UnitWeek, "wks": UnitWeek, "wks.": UnitWeek,
 	"week": UnitWeek, "weeks": UnitWeek,
+	"fortnight": UnitFortnight, "fortnights": UnitFortnight,
+	"forthnight": UnitFortnight, "forthnights": UnitFortnight,
 	// Weekday (business day) variations
 	"weekday": UnitWeekDay, "weekdays": UnitWeekDay,
 	// Month variations
@@ -94,42 +146,66 @@ var unitMap = map[string]string{
 }
 
 // normalizeTimeUnit converts various time unit notations to a canonical form.
-// Input should be lowercase.
+// Matching is case-insensitive; unrecognized units are returned unchanged.
 func normalizeTimeUnit(unit string) string {
-	if canonical, found := unitMap[unit]; found {
+	var buf [lookupKeyMax]byte
+	key := foldKey(unit, &buf)
+	if canonical, found := unitMap[string(key)]; found {
 		return canonical
 	}
 
-	trimmed := strings.TrimSuffix(unit, "s")
-	if canonical, found := unitMap[trimmed]; found {
-		return canonical
+	if n := len(key); n > 0 && key[n-1] == 's' {
+		if canonical, found := unitMap[string(key[:n-1])]; found {
+			return canonical
+		}
 	}
 
-	if strings.HasPrefix(unit, "day") {
+	if hasPrefixFold(unit, "day") {
 		return UnitDay
-	} else if strings.HasPrefix(unit, "weekday") {
+	} else if hasPrefixFold(unit, "weekday") {
 		return UnitWeekDay
-	} else if strings.HasPrefix(unit, "week") {
+	} else if hasPrefixFold(unit, "week") {
 		return UnitWeek
-	} else if strings.HasPrefix(unit, "month") {
+	} else if hasPrefixFold(unit, "month") {
 		return UnitMonth
-	} else if strings.HasPrefix(unit, "year") {
+	} else if hasPrefixFold(unit, "year") {
 		return UnitYear
-	} else if strings.HasPrefix(unit, "hour") || strings.HasPrefix(unit, "hr") {
+	} else if hasPrefixFold(unit, "hour") || hasPrefixFold(unit, "hr") {
 		return UnitHour
-	} else if strings.HasPrefix(unit, "min") {
+	} else if hasPrefixFold(unit, "min") {
 		return UnitMinute
-	} else if strings.HasPrefix(unit, "sec") {
+	} else if hasPrefixFold(unit, "sec") {
 		return UnitSecond
 	}
 
 	return unit
 }
 
+// RegisterTimeUnit adds name as an alias for one of the canonical unit
+// constants (UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth,
+// UnitYear, UnitHour, UnitMinute or UnitSecond). Matching is case-insensitive.
+//
+// Like RegisterMonthName, it must be called before any parsing takes place.
+func RegisterTimeUnit(name, unit string) error {
+	switch unit {
+	case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear,
+		UnitHour, UnitMinute, UnitSecond:
+	default:
+		return ErrInvalidTimeUnit
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return ErrInvalidTimeUnit
+	}
+	unitMap[name] = unit
+	return nil
+}
+
 // ordinalWordToNumber converts ordinal words ("first", "second", ..., "twelfth") to numbers.
 // Returns 0 for unrecognized words.
 func ordinalWordToNumber(word string) int {
-	switch strings.ToLower(word) {
+	var buf [lookupKeyMax]byte
+	switch string(foldKey(word, &buf)) {
 	case "first":
 		return 1
 	case "second":
@@ -158,6 +234,23 @@ func ordinalWordToNumber(word string) int {
 	return 0
 }
 
+// ordinalSuffix returns the English ordinal suffix for n ("st" for 1, "nd"
+// for 2, "rd" for 3, "th" for 11, ...).
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
 // parseTwoDigitYear converts a 2-digit year to a 4-digit year.
 // 00-69 → 2000-2069, 70-99 → 1970-1999.
 func parseTwoDigitYear(year int) int {
@@ -184,9 +277,53 @@ func applyAMPM(hour int, ampm string) int {
 	return hour + 12
 }
 
+// cutMeridian returns s without the "am" or "pm" it ends with, also written
+// "a.m." or "p.m." and possibly after a space, and that meridian, or s and
+// "" when it has none. s must be in lowercase.
+func cutMeridian(s string) (string, string) {
+	for _, m := range [...]string{"am", "pm", "a.m.", "p.m."} {
+		if rest, ok := strings.CutSuffix(s, m); ok && rest != "" {
+			return strings.TrimRight(rest, " "), m[:1] + "m"
+		}
+	}
+	return s, ""
+}
+
+// applyMeridian converts *hour, read from a time followed by the meridian
+// ampm as returned by cutMeridian, to 24-hour format. It reports false when
+// the time has a meridian but is not a 12-hour time: complete, set by whole
+// is false, or with an hour outside 1 to 12.
+func applyMeridian(hour *int, ampm string, whole bool) bool {
+	if ampm == "" {
+		return true
+	}
+	if !whole || *hour < 1 || *hour > 12 {
+		return false
+	}
+	*hour = applyAMPM(*hour, ampm)
+	return true
+}
+
+// monthDays holds the length of each month in a common year, indexed by
+// time.Month.
+var monthDays = [13]int{0, 31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
 // daysInMonth returns the number of days in the given month/year.
+// Out-of-range months are normalized the way time.Date does (month 13 is
+// January of the following year, month 0 is December of the previous one).
 func daysInMonth(year int, month time.Month) int {
-	nextMonth := time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
-	lastDay := nextMonth.AddDate(0, 0, -1)
-	return lastDay.Day()
+	if month < time.January || month > time.December {
+		m := int(month) - 1
+		year += m / 12
+		m %= 12
+		if m < 0 {
+			m += 12
+			year--
+		}
+		month = time.Month(m + 1)
+	}
+	if month == time.February && IsLeapYear(year) {
+		return 29
+	}
+	return monthDays[month]
 }
@@ -0,0 +1,89 @@
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegisterLookups(t *testing.T) {
+	if err := RegisterMonthName("Janvier", time.January); err != nil {
+		t.Fatalf("RegisterMonthName: %v", err)
+	}
+	if err := RegisterTimeUnit("Jours", UnitDay); err != nil {
+		t.Fatalf("RegisterTimeUnit: %v", err)
+	}
+	defer func() {
+		delete(monthNames, "janvier")
+		delete(unitMap, "jours")
+	}()
+
+	if m, ok := getMonthByName("janvier"); !ok || m != time.January {
+		t.Errorf("getMonthByName(janvier) = %v, %v", m, ok)
+	}
+	if u := normalizeTimeUnit("jours"); u != UnitDay {
+		t.Errorf("normalizeTimeUnit(jours) = %q, want %q", u, UnitDay)
+	}
+
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	got, err := StrToTime("15 Janvier 2024", Rel(base))
+	if err != nil {
+		t.Fatalf("StrToTime: %v", err)
+	}
+	if want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("StrToTime(15 Janvier 2024) = %v, want %v", got, want)
+	}
+	got, err = StrToTime("+3 jours", Rel(base))
+	if err != nil {
+		t.Fatalf("StrToTime: %v", err)
+	}
+	if want := base.AddDate(0, 0, 3); !got.Equal(want) {
+		t.Errorf("StrToTime(+3 jours) = %v, want %v", got, want)
+	}
+
+	if err := RegisterMonthName("foo", 13); !errors.Is(err, ErrInvalidDateComponent) {
+		t.Errorf("RegisterMonthName(13) error = %v", err)
+	}
+	if err := RegisterTimeUnit("foo", "decade"); !errors.Is(err, ErrInvalidTimeUnit) {
+		t.Errorf("RegisterTimeUnit(decade) error = %v", err)
+	}
+}
+
+func TestLookupsCaseInsensitive(t *testing.T) {
+	if m, ok := getMonthByName("DEC."); !ok || m != time.December {
+		t.Errorf("getMonthByName(DEC.) = %v, %v", m, ok)
+	}
+	if d := getDayOfWeek("Friday"); d != 5 {
+		t.Errorf("getDayOfWeek(Friday) = %d, want 5", d)
+	}
+	if u := normalizeTimeUnit("Hours"); u != UnitHour {
+		t.Errorf("normalizeTimeUnit(Hours) = %q, want %q", u, UnitHour)
+	}
+	if u := normalizeTimeUnit("MINUTESS"); u != UnitMinute {
+		t.Errorf("normalizeTimeUnit(MINUTESS) = %q, want %q", u, UnitMinute)
+	}
+	if n := ordinalWordToNumber("Third"); n != 3 {
+		t.Errorf("ordinalWordToNumber(Third) = %d, want 3", n)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		getMonthByName("September")
+		getDayOfWeek("WED")
+		normalizeTimeUnit("Secs")
+		ordinalWordToNumber("Twelfth")
+	})
+	if allocs != 0 {
+		t.Errorf("lookups allocated %v times, want 0", allocs)
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	for _, year := range []int{-400, -1, 0, 1900, 1999, 2000, 2023, 2024, 2100} {
+		for month := time.Month(-13); month <= 25; month++ {
+			want := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+			if got := daysInMonth(year, month); got != want {
+				t.Errorf("daysInMonth(%d, %d) = %d, want %d", year, month, got, want)
+			}
+		}
+	}
+}
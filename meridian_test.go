@@ -0,0 +1,36 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMeridianWithDate(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"march 5 3pm":              time.Date(2024, 3, 5, 15, 0, 0, 0, time.UTC),
+		"march 5, 10 a.m.":         time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+		"5 march 3pm":              time.Date(2024, 3, 5, 15, 0, 0, 0, time.UTC),
+		"5 march 3 pm":             time.Date(2024, 3, 5, 15, 0, 0, 0, time.UTC),
+		"5th march 2024 3:30 p.m.": time.Date(2024, 3, 5, 15, 30, 0, 0, time.UTC),
+		"3:30 pm UTC":              time.Date(2024, 3, 1, 15, 30, 0, 0, time.UTC),
+		"3:30pm +0000":             time.Date(2024, 3, 1, 15, 30, 0, 0, time.UTC),
+		"12:15am UTC":              time.Date(2024, 3, 1, 0, 15, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{
+		"15:30pm UTC",
+		"5 march 13pm",
+		"2024-03-05 15:00pm",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
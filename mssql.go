@@ -0,0 +1,96 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseMSSQLTimestamp parses the timestamps of SQL Server and Sybase, whose
+// milliseconds follow the seconds after a colon rather than a period: "Jan
+// 15 2023 10:30:45:123AM" (style 109) and "15 Jan 2023 10:30:45:123" (style
+// 113). The date is written month first or day first, or "2023-01-15"; the
+// fraction has one to nine digits and the meridian is optional.
+func parseMSSQLTimestamp(str string, loc *time.Location) (time.Time, bool) {
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	if n := len(fields); n > 1 && (fields[n-1] == "am" || fields[n-1] == "pm") {
+		fields = append(fields[:n-2:n-2], fields[n-2]+fields[n-1])
+	}
+	if len(fields) != 2 && len(fields) != 4 {
+		return time.Time{}, false
+	}
+
+	clock := fields[len(fields)-1]
+	meridian := ""
+	if strings.HasSuffix(clock, "am") || strings.HasSuffix(clock, "pm") {
+		clock, meridian = clock[:len(clock)-2], clock[len(clock)-2:]
+	}
+	hour, minute, second, nanos, ok := readMSSQLClock(clock)
+	if !ok {
+		return time.Time{}, false
+	}
+	if meridian != "" {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, false
+		}
+		hour = applyAMPM(hour, meridian)
+	}
+
+	var year, day int
+	var month time.Month
+	if len(fields) == 2 {
+		date, ok := parseISOFormat(fields[0], loc)
+		if !ok {
+			return time.Time{}, false
+		}
+		year, month, day = date.Date()
+	} else {
+		dayField, monthField := fields[1], fields[0]
+		if isAllDigits(monthField) {
+			dayField, monthField = monthField, dayField
+		}
+		if month, ok = getMonthByName(monthField); !ok || len(dayField) > 2 || !isAllDigits(dayField) ||
+			len(fields[2]) != 4 || !isAllDigits(fields[2]) {
+			return time.Time{}, false
+		}
+		year, day = digitsValue(fields[2]), digitsValue(dayField)
+		if !IsValidDate(year, int(month), day) {
+			return time.Time{}, false
+		}
+	}
+	return time.Date(year, month, day, hour, minute, second, nanos, loc), true
+}
+
+// readMSSQLClock reads "hh:mm:ss:fff", with one or two digits for the hour
+// and one to nine for the fraction.
+func readMSSQLClock(s string) (hour, minute, second, nanos int, ok bool) {
+	var i int
+	if hour, i, ok = readDigitField(s, 0, 1, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, 0, false
+	}
+	if minute, i, ok = readDigitField(s, i+1, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, 0, false
+	}
+	if second, i, ok = readDigitField(s, i+1, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, 0, false
+	}
+	frac := s[i+1:]
+	if frac == "" || len(frac) > 9 || !isAllDigits(frac) {
+		return 0, 0, 0, 0, false
+	}
+	nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+	return hour, minute, second, nanos, IsValidTime(hour, minute, second)
+}
+
+func parseMSSQLTimestampInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseMSSQLTimestamp(str, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.SetFraction(float64(t.Nanosecond()) / 1e9)
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,42 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMSSQLTimestamps(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"Jan 15 2023 10:30:45:123AM":    time.Date(2023, 1, 15, 10, 30, 45, 123000000, time.UTC),
+		"Jan 15 2023 10:30:45:123 PM":   time.Date(2023, 1, 15, 22, 30, 45, 123000000, time.UTC),
+		"Jan  5 2023 12:00:00:000AM":    time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+		"15 Jan 2023 10:30:45:123":      time.Date(2023, 1, 15, 10, 30, 45, 123000000, time.UTC),
+		"2023-01-15 10:30:45:5":         time.Date(2023, 1, 15, 10, 30, 45, 500000000, time.UTC),
+		"2023-01-15 10:30:45.123":       time.Date(2023, 1, 15, 10, 30, 45, 123000000, time.UTC),
+		"2023-01-15 10:30:45.123456789": time.Date(2023, 1, 15, 10, 30, 45, 123456789, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"Jan 15 2023 13:30:45:123PM",
+		"Jan 15 2023 10:30:45:",
+		"Jan 15 2023 10:30:45:1234567890",
+		"Feb 30 2023 10:30:45:123",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
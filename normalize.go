@@ -0,0 +1,24 @@
+package strtotime
+
+// canonicalLayout is the layout of the strings returned by Normalize, before
+// the zone.
+const canonicalLayout = "2006-01-02 15:04:05.999999999"
+
+// Normalize parses str like StrToTime and returns the result in a canonical
+// form that no longer depends on the time it was parsed at: "next fri" in
+// Paris becomes "2024-06-14 00:00:00 Europe/Paris". The date and time are
+// followed by a fraction of a second when there is one, and by the zone
+// name, or by the UTC offset when the zone has no name that StrToTime reads
+// back (time.Local). Parsing the returned string gives the same instant in
+// the same zone.
+func Normalize(str string, opts ...Option) (string, error) {
+	t, err := StrToTime(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	zone := t.Location().String()
+	if zone == "Local" {
+		zone = t.Format("-07:00")
+	}
+	return t.Format(canonicalLayout) + " " + zone, nil
+}
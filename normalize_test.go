@@ -0,0 +1,42 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("zone Europe/Paris unavailable: %v", err)
+	}
+	base := time.Date(2024, 6, 10, 10, 0, 0, 0, paris)
+	tests := map[string]string{
+		"next fri":                "2024-06-14 00:00:00 Europe/Paris",
+		"+1 week 2 days":          "2024-06-19 10:00:00 Europe/Paris",
+		"@1700000000.25":          "2023-11-14 23:13:20.25 Europe/Paris",
+		"2024-01-01 10:00 +05:30": "2024-01-01 10:00:00 +05:30",
+		"2024-01-01T10:00:00Z":    "2024-01-01 10:00:00 UTC",
+		"2024-01-01 10:00 EST":    "2024-01-01 10:00:00 EST",
+	}
+	for input, want := range tests {
+		got, err := Normalize(input, Rel(base), InTZ(paris))
+		if err != nil {
+			t.Errorf("Normalize(%q) error: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", input, got, want)
+		}
+		// The canonical form reads back as the same instant and zone.
+		orig, _ := StrToTime(input, Rel(base), InTZ(paris))
+		back, err := StrToTime(got, InTZ(time.UTC))
+		if err != nil || !back.Equal(orig) || back.Location().String() != orig.Location().String() {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", got, back, err, orig)
+		}
+	}
+
+	if _, err := Normalize("not a date"); err == nil {
+		t.Errorf("Normalize(\"not a date\") succeeded")
+	}
+}
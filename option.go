@@ -26,3 +26,347 @@ type tzOption struct {
 func (t tzOption) isOption() bool {
 	return true
 }
+
+// DisableTZDetection turns off recognition of timezone names and
+// abbreviations ("EST", "Europe/Paris", ...) in the input. Use it together
+// with InTZ when the zone is known and inputs never carry one: it skips the
+// timezone lookups and keeps words from being mistaken for zone names.
+// Inputs that do name a zone fail to parse. Numeric offsets such as "+0200"
+// and the ISO 8601 "Z" suffix are still honored.
+func DisableTZDetection() Option {
+	return noTZDetectionOption{}
+}
+
+// noTZDetectionOption is an internal type for the DisableTZDetection option
+type noTZDetectionOption struct{}
+
+func (noTZDetectionOption) isOption() bool {
+	return true
+}
+
+// Rollover makes out-of-range components in numeric dates and times roll
+// over into the next unit instead of failing the parse, the way PHP
+// normalizes them: "2023-01-32" is February 1st, "2023-13-01" is January 1st
+// of the next year and "25:70:00" is 02:10:00 tomorrow. It covers the
+// "YYYY-MM-DD", "YYYY/MM/DD" and "hh:mm[:ss]" forms and their combination,
+// and lets "June 31 2025" be July 1st instead of an InvalidDateError.
+// Inputs that parse without it are unaffected.
+func Rollover() Option {
+	return rolloverOption{}
+}
+
+// rolloverOption is an internal type for the Rollover option
+type rolloverOption struct{}
+
+func (rolloverOption) isOption() bool {
+	return true
+}
+
+// ExtendedYears lifts PHP's four-digit limit on the year of ISO 8601 dates,
+// accepting expanded years such as "12345-06-07" or "+10000-01-01T00:00:00Z"
+// up to the range time.Time can represent, roughly 292 billion years either
+// side of year 1. Years beyond it fail the parse instead of wrapping around.
+func ExtendedYears() Option {
+	return extendedYearsOption{}
+}
+
+// extendedYearsOption is an internal type for the ExtendedYears option
+type extendedYearsOption struct{}
+
+func (extendedYearsOption) isOption() bool {
+	return true
+}
+
+// Eras accepts years followed by an era designator, "BC", "BCE", "AD" or
+// "CE" (optionally dotted), as in "March 15, 44 BC" or "14 AD". Such years
+// are read literally, without the current-century mapping of two-digit
+// years, and a year on its own stands for January 1st of that year. Years
+// before the common era map onto Go's astronomical numbering: 1 BC is year 0
+// and 44 BC is year -43. ISO 8601 expanded years such as "-0044-03-15"
+// already use that numbering and are accepted without this option.
+func Eras() Option {
+	return erasOption{}
+}
+
+// erasOption is an internal type for the Eras option
+type erasOption struct{}
+
+func (erasOption) isOption() bool {
+	return true
+}
+
+// YearZero accepts dates in year 0 ("0000-01-01", "Jan 1 0000"), read as
+// ISO 8601 does, as the year before year 1 (1 BC). By default such dates
+// fail with an *InvalidDateError, whatever their format. PHP's zero date
+// "0000-00-00" is accepted either way.
+func YearZero() Option {
+	return yearZeroOption{}
+}
+
+// yearZeroOption is an internal type for the YearZero option
+type yearZeroOption struct{}
+
+func (yearZeroOption) isOption() bool {
+	return true
+}
+
+// DayPolicy selects how day and week offsets ("+1 day", "tomorrow",
+// "-2 weeks") are applied when they cross a daylight saving time change.
+type DayPolicy int
+
+const (
+	// CalendarDays moves by calendar days and keeps the wall clock time, as
+	// PHP does: "+1 day" from 12:00 the day before a spring-forward change
+	// is 12:00 the next day, only 23 hours later. This is the default.
+	CalendarDays DayPolicy = iota
+
+	// ElapsedDays moves by multiples of 24 hours: "+1 day" from 12:00 the
+	// day before a spring-forward change is 13:00 the next day. "tomorrow"
+	// and "yesterday" are midnight of the day 24 hours after or before the
+	// reference time.
+	ElapsedDays
+)
+
+// DayArithmetic sets the policy used when day and week offsets are applied.
+func DayArithmetic(p DayPolicy) Option {
+	return dayPolicyOption{policy: p}
+}
+
+// dayPolicyOption is an internal type for the DayArithmetic option
+type dayPolicyOption struct {
+	policy DayPolicy
+}
+
+func (dayPolicyOption) isOption() bool {
+	return true
+}
+
+// TimeT32 restricts results to the range of a signed 32-bit Unix timestamp,
+// 1901-12-13 20:45:52 to 2038-01-19 03:14:07 UTC, for parity with
+// strtotime on 32-bit PHP builds, which returns false outside it. Results
+// outside the range fail with ErrOutOfRange, or are moved to the nearest end
+// of the range when clamp is true.
+func TimeT32(clamp bool) Option {
+	return timeT32Option{clamp: clamp}
+}
+
+// timeT32Option is an internal type for the TimeT32 option
+type timeT32Option struct {
+	clamp bool
+}
+
+func (timeT32Option) isOption() bool {
+	return true
+}
+
+// MonthPolicy selects how adding months or years to a date handles a day
+// that the target month doesn't have.
+type MonthPolicy int
+
+const (
+	// MonthOverflowPHP rolls the excess days over into the following month,
+	// as PHP does: "2023-01-31 +1 month" is March 3. This is the default.
+	MonthOverflowPHP MonthPolicy = iota
+
+	// MonthKeepEnd keeps dates that fall on the last day of their month on
+	// the last day of the target month: "2023-05-31 -1 month" is April 30
+	// and "2023-02-28 +1 month" is March 31. Other dates are handled as with
+	// MonthOverflowPHP.
+	MonthKeepEnd
+
+	// MonthClamp moves a day the target month doesn't have back to that
+	// month's last day: "2023-01-31 +1 month" is February 28 and
+	// "2024-02-29 +1 year" is February 28, 2025.
+	MonthClamp
+
+	// MonthError fails the parse when the target month doesn't have the
+	// day: "2023-01-31 +1 month" is an *InvalidDateError for February 31st,
+	// while "2023-01-15 +1 month" is February 15. Interval.AddTo, which
+	// cannot fail, rolls such days over as with MonthOverflowPHP.
+	MonthError
+)
+
+// MonthArithmetic sets the policy used when month or year offsets are
+// applied, e.g. "2023-05-31 -1 month" or "+2 years". Years count as twelve
+// months, so the policy also decides where February 29th lands a year on.
+func MonthArithmetic(p MonthPolicy) Option {
+	return monthPolicyOption{policy: p}
+}
+
+// monthPolicyOption is an internal type for the MonthArithmetic option
+type monthPolicyOption struct {
+	policy MonthPolicy
+}
+
+func (monthPolicyOption) isOption() bool {
+	return true
+}
+
+// LeapSecondPolicy selects how a seconds field of 60, as found in
+// timestamps that record a leap second ("2016-12-31 23:59:60"), is handled.
+type LeapSecondPolicy int
+
+const (
+	// LeapSecondReject fails the parse of most such inputs. This is the
+	// default.
+	LeapSecondReject LeapSecondPolicy = iota
+
+	// LeapSecondClamp reads the 60th second as the last moment of the 59th:
+	// "23:59:60" is 23:59:59 and any fraction of a second is dropped.
+	LeapSecondClamp
+
+	// LeapSecondRoll reads the 60th second as the first second of the next
+	// minute: "2016-12-31 23:59:60" is 2017-01-01 00:00:00.
+	LeapSecondRoll
+)
+
+// LeapSeconds sets how a seconds field of 60 is handled.
+func LeapSeconds(p LeapSecondPolicy) Option {
+	return leapSecondOption{policy: p}
+}
+
+// leapSecondOption is an internal type for the LeapSeconds option
+type leapSecondOption struct {
+	policy LeapSecondPolicy
+}
+
+func (leapSecondOption) isOption() bool {
+	return true
+}
+
+// Lenient accepts inputs whose beginning parses as a date and ignores the
+// text after it, as earlier versions did: "2023-01-15 zzz" is January 15th.
+// It also skips the words of relative expressions that it does not know,
+// such as "please" in "next friday please at 10am", where PHP would fail.
+// By default the whole input must be understood, and such inputs fail with
+// an error wrapping ErrTrailingText that quotes the unread text, or with an
+// "unexpected token" error.
+func Lenient() Option {
+	return lenientOption{skipWords: true}
+}
+
+// lenientOption is an internal type for the Lenient option. DateParse
+// ignores trailing text but reports unknown words, as PHP does, so it uses
+// one with skipWords unset.
+type lenientOption struct {
+	skipWords bool
+}
+
+func (lenientOption) isOption() bool {
+	return true
+}
+
+// WeekStartsOn sets the day weeks start on in week-based expressions:
+// "this week", "next week" and "last week" are that day of the week, a
+// weekday followed by "this week" is looked up in the week starting on it,
+// and "start of week" is midnight on it. Weeks start on Monday by default,
+// as in PHP. Week numbers ("2024-W05", "week 5 2024") follow ISO 8601 and
+// always start on Monday.
+func WeekStartsOn(d time.Weekday) Option {
+	return weekStartOption{day: d}
+}
+
+// weekStartOption is an internal type for the WeekStartsOn option
+type weekStartOption struct {
+	day time.Weekday
+}
+
+func (weekStartOption) isOption() bool {
+	return true
+}
+
+// Strict rejects the inputs that parse only thanks to a tolerance: text that
+// the grammar skipped without recognizing it ("next business" is not read as
+// "next"), filler words dropped to retry the parse ("on monday at 10am") and
+// anything PHP reports with a warning, such as a second timezone or, with
+// Rollover, a date that doesn't exist. Such inputs fail with an error
+// wrapping ErrStrict that names the text and its position. Strict overrides
+// Lenient.
+func Strict() Option {
+	return strictOption{}
+}
+
+// strictOption is an internal type for the Strict option
+type strictOption struct{}
+
+func (strictOption) isOption() bool {
+	return true
+}
+
+// DayFirst reads numeric dates written with slashes day first, as in most
+// of Europe, when on is true: "03/04/2023" is April 3rd rather than March
+// 4th, and "3/4" is the 3rd of April. Dates with dashes or dots, such as
+// "03-04-2023" and "03.04.2023", are already read day first, as PHP does,
+// and dates starting with a four-digit year are not affected. DayFirst(false)
+// restores the default, for instance over SetDefaultOptions.
+func DayFirst(on bool) Option {
+	return dayFirstOption{on: on}
+}
+
+// dayFirstOption is an internal type for the DayFirst option
+type dayFirstOption struct {
+	on bool
+}
+
+func (dayFirstOption) isOption() bool {
+	return true
+}
+
+// parseSettings gathers the behavior switches set through options.
+type parseSettings struct {
+	noTZDetection bool
+	rollover      bool
+	extendedYears bool
+	eras          bool
+	yearZero      bool
+	timeT32       bool
+	timeT32Clamp  bool
+	months        MonthPolicy
+	days          DayPolicy
+	leapSeconds   LeapSecondPolicy
+	lenient       bool
+	skipWords     bool
+	weekStart     time.Weekday
+	dayFirst      bool
+	strict        bool
+}
+
+// resolveSettings returns the parseSettings selected by opts.
+func resolveSettings(opts []Option) parseSettings {
+	s := parseSettings{weekStart: time.Monday}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case noTZDetectionOption:
+			s.noTZDetection = true
+		case rolloverOption:
+			s.rollover = true
+		case extendedYearsOption:
+			s.extendedYears = true
+		case erasOption:
+			s.eras = true
+		case yearZeroOption:
+			s.yearZero = true
+		case timeT32Option:
+			s.timeT32, s.timeT32Clamp = true, o.clamp
+		case monthPolicyOption:
+			s.months = o.policy
+		case dayPolicyOption:
+			s.days = o.policy
+		case leapSecondOption:
+			s.leapSeconds = o.policy
+		case lenientOption:
+			s.lenient = true
+			s.skipWords = s.skipWords || o.skipWords
+		case weekStartOption:
+			s.weekStart = o.day
+		case dayFirstOption:
+			s.dayFirst = o.on
+		case strictOption:
+			s.strict = true
+		}
+	}
+	if s.strict {
+		s.lenient, s.skipWords = false, false
+	}
+	return s
+}
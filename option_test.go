@@ -0,0 +1,397 @@
+package strtotime
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDisableTZDetection(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	opts := []Option{Rel(base), InTZ(time.UTC), DisableTZDetection()}
+
+	valid := map[string]time.Time{
+		"2024-01-15 10:00:00":       time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		"2024-01-15 10:00:00 +0500": time.Date(2024, 1, 15, 5, 0, 0, 0, time.UTC),
+		"2024-01-15t10:00:00z":      time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		"tomorrow":                  time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+		"next monday":               time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range valid {
+		got, err := StrToTime(input, opts...)
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"2024-01-15 10:00:00 est",
+		"10:00 pst",
+		"2024-01-15 europe/paris",
+		"june 1 1985 16:30:00 europe/paris",
+		"@1700000000 utc",
+		"est",
+	} {
+		if _, err := StrToTime(input, opts...); err == nil {
+			t.Errorf("StrToTime(%q) should fail with timezone detection disabled", input)
+		}
+		if _, err := StrToTime(input, Rel(base)); err != nil {
+			t.Errorf("StrToTime(%q) without the option: %v", input, err)
+		}
+	}
+}
+
+func TestMonthArithmetic(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		overflow time.Time
+		keepEnd  time.Time
+		clamp    time.Time
+	}{
+		{"2023-05-31 -1 month", time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"2023-02-28 +1 month", time.Date(2023, 3, 28, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 28, 0, 0, 0, 0, time.UTC)},
+		{"2023-01-31 10:00 +1 month", time.Date(2023, 3, 3, 10, 0, 0, 0, time.UTC), time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC), time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{"2023-03-31 -13 months", time.Date(2022, 3, 3, 0, 0, 0, 0, time.UTC), time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"2024-02-29 +1 year", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"31.05.2023 +1 year -1 month", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"2023-05-30 -1 month", time.Date(2023, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"2023-02-28 +1 year", time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"2023-05-15 +1 month", time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC), time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC), time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		for _, c := range []struct {
+			opts []Option
+			want time.Time
+		}{
+			{[]Option{Rel(base), InTZ(time.UTC)}, tt.overflow},
+			{[]Option{Rel(base), InTZ(time.UTC), MonthArithmetic(MonthOverflowPHP)}, tt.overflow},
+			{[]Option{Rel(base), InTZ(time.UTC), MonthArithmetic(MonthKeepEnd)}, tt.keepEnd},
+			{[]Option{Rel(base), InTZ(time.UTC), MonthArithmetic(MonthClamp)}, tt.clamp},
+		} {
+			got, err := StrToTime(tt.input, c.opts...)
+			if err != nil {
+				t.Errorf("StrToTime(%q) error: %v", tt.input, err)
+				continue
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("StrToTime(%q) with %d options = %v, want %v", tt.input, len(c.opts), got, c.want)
+			}
+		}
+	}
+
+	// Relative offsets from the reference time follow the policy too.
+	got, err := StrToTime("-1 month", Rel(time.Date(2024, 3, 31, 8, 0, 0, 0, time.UTC)), MonthArithmetic(MonthKeepEnd))
+	if want := time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("StrToTime(\"-1 month\") = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestMonthError(t *testing.T) {
+	base := time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC)
+	opts := []Option{Rel(base), InTZ(time.UTC), MonthArithmetic(MonthError)}
+
+	for _, input := range []string{
+		"2023-01-31 +1 month",
+		"2024-02-29 +1 year",
+		"2023-03-31 -1 month",
+		"+1 month",
+		"next month",
+		"+1 week +1 month",
+	} {
+		if got, err := StrToTime(input, opts...); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("StrToTime(%q) = %v, %v, want ErrInvalidDate", input, got, err)
+		}
+	}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2023-01-15 +1 month", time.Date(2023, 2, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024-02-29 +4 years", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"last month", time.Date(2022, 12, 31, 10, 0, 0, 0, time.UTC)},
+		{"+2 months", time.Date(2023, 3, 31, 10, 0, 0, 0, time.UTC)},
+		{"last day of next month", time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got, err := StrToTime(tt.input, opts...); err != nil || !got.Equal(tt.want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestTimeT32(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  time.Time // zero when out of range
+		clamp time.Time
+	}{
+		{"2038-01-19 03:14:07 utc", time.Unix(math.MaxInt32, 0), time.Unix(math.MaxInt32, 0)},
+		{"2038-01-19 03:14:08 utc", time.Time{}, time.Unix(math.MaxInt32, 0)},
+		{"1901-12-13 20:45:52 utc", time.Unix(math.MinInt32, 0), time.Unix(math.MinInt32, 0)},
+		{"1901-12-13 20:45:51 utc", time.Time{}, time.Unix(math.MinInt32, 0)},
+		{"@2147483648", time.Time{}, time.Unix(math.MaxInt32, 0)},
+		{"2037-12-31 +1 year", time.Time{}, time.Unix(math.MaxInt32, 0)},
+		{"2040-06-01 -5 years", time.Date(2035, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2035, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"tomorrow", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := StrToTime(tt.input, Rel(base), InTZ(time.UTC), TimeT32(false))
+		if tt.want.IsZero() {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("StrToTime(%q) = %v, %v, want ErrOutOfRange", tt.input, got, err)
+			}
+		} else if err != nil || !got.Equal(tt.want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+
+		got, err = StrToTime(tt.input, Rel(base), InTZ(time.UTC), TimeT32(true))
+		if err != nil || !got.Equal(tt.clamp) {
+			t.Errorf("StrToTime(%q) with clamping = %v, %v, want %v", tt.input, got, err, tt.clamp)
+		}
+	}
+
+	if _, err := StrToTime("2040-01-01", Rel(base)); err != nil {
+		t.Errorf("StrToTime(\"2040-01-01\") without TimeT32: %v", err)
+	}
+}
+
+func TestLenient(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input  string
+		unread string
+		want   time.Time
+	}{
+		{"01/15/2023 zzq", "zzq", time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"11 Oct 2005 bogus text", "bogus text", time.Date(2005, 10, 11, 0, 0, 0, 0, time.UTC)},
+		{"19970523091528 zzq", "zzq", time.Date(1997, 5, 23, 9, 15, 28, 0, time.UTC)},
+		{"11/20/2005 8:00 AM zzq", "zzq", time.Date(2005, 11, 20, 8, 0, 0, 0, time.UTC)},
+		{"@1700000000 zzq", "zzq", time.Unix(1700000000, 0)},
+		{"15 March 44 BC", "bc", time.Date(2044, 3, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		_, err := StrToTime(tt.input, Rel(base))
+		if !errors.Is(err, ErrTrailingText) {
+			t.Errorf("StrToTime(%q) error = %v, want ErrTrailingText", tt.input, err)
+		} else if want := newTrailingTextError(tt.unread).Error(); !strings.HasSuffix(err.Error(), want) {
+			t.Errorf("StrToTime(%q) error = %v, want it to end in %q", tt.input, err, want)
+		}
+
+		got, err := StrToTime(tt.input, Rel(base), Lenient())
+		if err != nil {
+			t.Errorf("StrToTime(%q) with Lenient error: %v", tt.input, err)
+		} else if !got.Equal(tt.want) {
+			t.Errorf("StrToTime(%q) with Lenient = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	// Trailing text that completes the expression is read, not ignored.
+	for input, want := range map[string]time.Time{
+		"11 Oct 2005 +1 day":        time.Date(2005, 10, 12, 0, 0, 0, 0, time.UTC),
+		"19970523091528 +1 day":     time.Date(1997, 5, 24, 9, 15, 28, 0, time.UTC),
+		"11/20/2005 8:00 AM +1 day": time.Date(2005, 11, 21, 8, 0, 0, 0, time.UTC),
+		"-0044-03-15 +1 day":        time.Date(-44, 3, 16, 0, 0, 0, 0, time.UTC),
+		"@1700000000 utc":           time.Unix(1700000000, 0),
+	} {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+		} else if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	// The text after a complete date is ignored, whichever parser reads it.
+	for input, want := range map[string]time.Time{
+		"2023-01-15 zzz":                 time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		"2023-01-15 zzq":                 time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		"2023-01-15 bogus":               time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		"2023-01-15T10:00:00 bogus text": time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC),
+	} {
+		if _, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) without Lenient succeeded, want an error", input)
+		}
+		got, err := StrToTime(input, Rel(base), Lenient())
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) with Lenient = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	// Unknown words are skipped, with a warning.
+	for input, want := range map[string]time.Time{
+		"next friday please at 10am": time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC),
+		"tomorrow please":            time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+		"+1 day or so":               time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC),
+	} {
+		if _, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) without Lenient succeeded, want an error", input)
+		}
+		got, err := StrToTime(input, Rel(base), Lenient())
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) with Lenient = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	if _, err := StrToTime("please", Rel(base), Lenient()); err == nil {
+		t.Error("StrToTime(\"please\") with Lenient succeeded, want an error")
+	}
+}
+
+func TestWeekStartsOn(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC) // a Friday
+
+	tests := []struct {
+		input    string
+		monday   time.Time
+		sunday   time.Time
+		saturday time.Time
+	}{
+		{"this week", time.Date(2024, 2, 26, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 25, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 24, 10, 0, 0, 0, time.UTC)},
+		{"next week", time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC), time.Date(2024, 3, 3, 10, 0, 0, 0, time.UTC), time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{"last week", time.Date(2024, 2, 19, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 18, 10, 0, 0, 0, time.UTC), time.Date(2024, 2, 17, 10, 0, 0, 0, time.UTC)},
+		{"sunday this week", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC)},
+		{"saturday next week", time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		for _, c := range []struct {
+			opts []Option
+			want time.Time
+		}{
+			{nil, tt.monday},
+			{[]Option{WeekStartsOn(time.Monday)}, tt.monday},
+			{[]Option{WeekStartsOn(time.Sunday)}, tt.sunday},
+			{[]Option{WeekStartsOn(time.Saturday)}, tt.saturday},
+		} {
+			got, err := StrToTime(tt.input, append(c.opts, Rel(base))...)
+			if err != nil || !got.Equal(c.want) {
+				t.Errorf("StrToTime(%q) with %v = %v, %v, want %v", tt.input, c.opts, got, err, c.want)
+			}
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, input := range []string{
+		"next business",
+		"10:00 pst pst",
+		"2023-02-30",
+	} {
+		if _, err := StrToTime(input, Rel(base)); err != nil {
+			t.Errorf("StrToTime(%q) without Strict: %v", input, err)
+		}
+		if got, err := StrToTime(input, Rel(base), Strict()); !errors.Is(err, ErrStrict) {
+			t.Errorf("StrToTime(%q, Strict()) = %v, %v, want ErrStrict", input, got, err)
+		}
+	}
+
+	// Filler words fail as they do in PHP.
+	for _, in := range []string{"on monday at 10am", "monday at 10am", "the 4th of july"} {
+		if got, err := StrToTime(in, Rel(base), Strict()); !errors.Is(err, ErrStrict) {
+			t.Errorf("StrToTime(%q, Strict()) = %v, %v, want ErrStrict", in, got, err)
+		}
+	}
+
+	tests := map[string]time.Time{
+		"2023-01-15 10:30":      time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"next friday":           time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+		"monday, 3pm":           time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC),
+		"tomorrow 10am +1 hour": time.Date(2024, 3, 2, 11, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		if got, err := StrToTime(input, Rel(base), Strict()); err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q, Strict()) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	// Strict overrides Lenient.
+	if _, err := StrToTime("11 oct 2005 zzz", Rel(base), Lenient(), Strict()); !errors.Is(err, ErrTrailingText) {
+		t.Errorf("StrToTime with Lenient and Strict error = %v, want ErrTrailingText", err)
+	}
+}
+
+func TestDayFirst(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input      string
+		dayFirst   time.Time
+		monthFirst time.Time // zero when it doesn't parse month first
+	}{
+		{"03/04/2023", time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"3/4", time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"31/12/23 10am", time.Date(2023, 12, 31, 10, 0, 0, 0, time.UTC), time.Time{}},
+		{"Mon 4/3/2024", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC)},
+		{"13/4/2023, 10:00", time.Date(2023, 4, 13, 10, 0, 0, 0, time.UTC), time.Time{}},
+		{"1/2/2023 +1 day", time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"2023/03/04", time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2023, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"03-04-2023", time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"03.04.2023", time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := StrToTime(tt.input, Rel(base), DayFirst(true))
+		if err != nil || !got.Equal(tt.dayFirst) {
+			t.Errorf("StrToTime(%q, DayFirst(true)) = %v, %v, want %v", tt.input, got, err, tt.dayFirst)
+		}
+		got, err = StrToTime(tt.input, Rel(base), DayFirst(true), DayFirst(false))
+		if tt.monthFirst.IsZero() {
+			if err == nil {
+				t.Errorf("StrToTime(%q, DayFirst(false)) = %v, want an error", tt.input, got)
+			}
+		} else if err != nil || !got.Equal(tt.monthFirst) {
+			t.Errorf("StrToTime(%q, DayFirst(false)) = %v, %v, want %v", tt.input, got, err, tt.monthFirst)
+		}
+	}
+
+	if _, err := StrToTime("31/4/2023", Rel(base), DayFirst(true)); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("StrToTime(\"31/4/2023\", DayFirst(true)) error = %v, want ErrInvalidDate", err)
+	}
+}
+
+func TestYearZero(t *testing.T) {
+	yearZero := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, input := range []string{
+		"0000-01-01",
+		"0000/01/01",
+		"01/01/0000",
+		"1.1.0000",
+		"Jan 1 0000",
+		"1 January 0000",
+		"-0000-01-01",
+	} {
+		_, err := StrToTime(input, InTZ(time.UTC))
+		var de *InvalidDateError
+		if !errors.As(err, &de) || de.Year != 0 {
+			t.Errorf("StrToTime(%q) error = %v, want an InvalidDateError for year 0", input, err)
+		}
+
+		got, err := StrToTime(input, InTZ(time.UTC), YearZero())
+		if err != nil || !got.Equal(yearZero) {
+			t.Errorf("StrToTime(%q) with YearZero = %v, %v, want %v", input, got, err, yearZero)
+		}
+	}
+
+	// PHP's zero date and dates reaching year 0 through arithmetic are not
+	// affected.
+	for input, want := range map[string]time.Time{
+		"0000-00-00":        time.Date(-1, 11, 30, 0, 0, 0, 0, time.UTC),
+		"0001-01-01 -1 day": time.Date(0, 12, 31, 0, 0, 0, 0, time.UTC),
+	} {
+		got, err := StrToTime(input, InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
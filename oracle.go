@@ -0,0 +1,78 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseOracleTimestamp parses Oracle's default TIMESTAMP format, "DD-MON-RR
+// HH.MI.SSXFF AM" as in "15-JAN-23 10.30.00.000000 AM": a DD-MON-YY(YY) date
+// followed by a dotted time with an optional fraction of up to nine digits
+// and an optional meridian. Two-digit years use the same pivot as the date
+// on its own, so 69 is 2069 and 70 is 1970.
+func parseOracleTimestamp(str string, loc *time.Location) (time.Time, bool) {
+	datePart, timePart, ok := strings.Cut(str, " ")
+	if !ok || datePart == "" || !isDigit(datePart[0]) {
+		return time.Time{}, false
+	}
+	date, ok := parseMonthNameFormat(datePart, loc)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	timePart, meridian, _ := strings.Cut(timePart, " ")
+	hour, i, ok := readDigitField(timePart, 0, 1, 2)
+	if !ok || i >= len(timePart) || timePart[i] != '.' {
+		return time.Time{}, false
+	}
+	minute, i, ok := readDigitField(timePart, i+1, 2, 2)
+	if !ok || i >= len(timePart) || timePart[i] != '.' {
+		return time.Time{}, false
+	}
+	second, i, ok := readDigitField(timePart, i+1, 2, 2)
+	if !ok {
+		return time.Time{}, false
+	}
+	nanos := 0
+	if i < len(timePart) {
+		if timePart[i] != '.' {
+			return time.Time{}, false
+		}
+		frac := timePart[i+1:]
+		if frac == "" || len(frac) > 9 || !isAllDigits(frac) {
+			return time.Time{}, false
+		}
+		nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+	}
+
+	switch meridian {
+	case "":
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return time.Time{}, false
+		}
+		hour = applyAMPM(hour, meridian)
+	default:
+		return time.Time{}, false
+	}
+	if !IsValidTime(hour, minute, second) {
+		return time.Time{}, false
+	}
+
+	y, m, d := date.Date()
+	return time.Date(y, m, d, hour, minute, second, nanos, loc), true
+}
+
+func parseOracleTimestampInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseOracleTimestamp(str, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.SetFraction(float64(t.Nanosecond()) / 1e9)
+	pd.setMaterialized(t)
+	return true
+}
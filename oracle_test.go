@@ -0,0 +1,43 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOracleDates(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"30-JUN-08":                    time.Date(2008, 6, 30, 0, 0, 0, 0, time.UTC),
+		"15-JAN-2023":                  time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		"01-JAN-69":                    time.Date(2069, 1, 1, 0, 0, 0, 0, time.UTC),
+		"01-JAN-70":                    time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		"15-JAN-23 10.30.00.000000 AM": time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
+		"15-JAN-23 02.15.30.250 PM":    time.Date(2023, 1, 15, 14, 15, 30, 250000000, time.UTC),
+		"15-JAN-23 12.00.00 AM":        time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		"15-JAN-2023 23.59.59":         time.Date(2023, 1, 15, 23, 59, 59, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"15-JAN-23 13.00.00 PM",
+		"15-JAN-23 10.30.00 XM",
+		"15-JAN-23 10.61.00",
+		"15-JAN-23 10.30.00.1234567890",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
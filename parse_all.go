@@ -0,0 +1,142 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ambiguousZoneLocations are the zones the abbreviations of ambiguousZones
+// may stand for, the most common first.
+var ambiguousZoneLocations = map[string][]string{
+	"ast": {"America/Halifax", "Asia/Riyadh"},
+	"bst": {"Europe/London", "Asia/Dhaka"},
+	"cst": {"America/Chicago", "Asia/Shanghai", "America/Havana"},
+	"gst": {"Asia/Dubai", "Atlantic/South_Georgia"},
+	"ist": {"Asia/Kolkata", "Asia/Jerusalem", "Europe/Dublin"},
+	"pst": {"America/Los_Angeles", "Asia/Karachi"},
+	"sst": {"Pacific/Pago_Pago", "Asia/Singapore"},
+}
+
+// Candidate is one interpretation of an input, returned by ParseAll.
+type Candidate struct {
+	Time time.Time
+	// Score is how plausible the interpretation is, between 0 and 1. The
+	// reading of StrToTime scores 1.
+	Score float64
+	// Reading describes the interpretation: "as written" for StrToTime's,
+	// otherwise the field order of a numeric date ("day/month/year"), the
+	// zone an abbreviation was taken for ("IST as Asia/Jerusalem"), "Unix
+	// timestamp", "Unix timestamp in milliseconds" or "year".
+	Reading string
+}
+
+// ParseAll returns every plausible interpretation of str, the most
+// plausible first, so that interactive interfaces can offer a choice
+// rather than silently guess. Besides the reading of StrToTime, numeric
+// dates are read in each field order they allow ("01/02/03"), zone
+// abbreviations shared by several zones as each of them ("IST"), numbers of
+// eight digits or more as Unix timestamps and lone four-digit numbers as
+// years. Each alternative changes one part of the input; interpretations
+// giving the same time are listed once. The error of StrToTime is returned
+// when there is none.
+func ParseAll(str string, opts ...Option) ([]Candidate, error) {
+	var cands []Candidate
+	add := func(t time.Time, score float64, reading string) {
+		for i, c := range cands {
+			if c.Time.Equal(t) {
+				cands[i].Score = max(c.Score, score)
+				return
+			}
+		}
+		cands = append(cands, Candidate{Time: t, Score: score, Reading: reading})
+	}
+
+	t, err := StrToTime(str, opts...)
+	if err == nil {
+		add(t, 1, "as written")
+	}
+
+	// Alternative readings are not reported to OnParse hooks.
+	opts = withDefaults(opts)
+	parse := func(s string) (time.Time, error) {
+		return checkedParse(s, opts, newParsedDate())
+	}
+	words := strings.Fields(str)
+	replaced := func(i int, w string) string {
+		rest := make([]string, len(words))
+		copy(rest, words)
+		rest[i] = w
+		return strings.Join(rest, " ")
+	}
+	for i, word := range words {
+		w := strings.ToLower(strings.TrimSuffix(word, ","))
+		for _, order := range numericDateOrders(w) {
+			if iso, ok := reorderNumericDate(w, order); ok {
+				if t, err := parse(replaced(i, iso)); err == nil {
+					add(t, 0.5, order)
+				}
+			}
+		}
+		for k, name := range ambiguousZoneLocations[w] {
+			if t, err := parse(replaced(i, name)); err == nil {
+				add(t, 0.5-0.1*float64(k), strings.ToUpper(w)+" as "+name)
+			}
+		}
+	}
+
+	if len(words) == 1 && isAllDigits(words[0]) {
+		w := words[0]
+		_, loc := resolveOptions(opts)
+		switch n, _ := strconv.ParseInt(w, 10, 64); {
+		case len(w) == 4:
+			if t, err := parse(w + "-01-01"); err == nil {
+				add(t, 0.5, "year")
+			}
+		case len(w) >= 8 && len(w) <= 11:
+			// Timestamps of fewer than ten digits are before 1973.
+			score := 0.5
+			if len(w) < 10 {
+				score = 0.3
+			}
+			add(time.Unix(n, 0).In(loc), score, "Unix timestamp")
+		case len(w) >= 12 && len(w) <= 14:
+			add(time.UnixMilli(n).In(loc), 0.5, "Unix timestamp in milliseconds")
+		}
+	}
+
+	if len(cands) == 0 {
+		if err == nil {
+			err = fmt.Errorf("%w %q", ErrInvalidDateFormat, str)
+		}
+		return nil, err
+	}
+	sort.SliceStable(cands, func(i, j int) bool { return cands[i].Score > cands[j].Score })
+	return cands, nil
+}
+
+// reorderNumericDate rewrites the numeric date w, read in the field order
+// order as returned by numericDateOrders, as "YYYY-MM-DD".
+func reorderNumericDate(w, order string) (string, bool) {
+	a, b, c := splitNumericDate(w, w[strings.IndexAny(w, "/-.")])
+	var year, month, day string
+	switch order {
+	case "month/day/year":
+		month, day, year = a, b, c
+	case "day/month/year":
+		day, month, year = a, b, c
+	case "year/month/day":
+		year, month, day = a, b, c
+	default:
+		return "", false
+	}
+	y := digitsValue(year)
+	if len(year) == 2 {
+		y = parseTwoDigitYear(y)
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", y, digitsValue(month), digitsValue(day)), true
+}
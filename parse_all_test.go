@@ -0,0 +1,83 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAll(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	type cand struct {
+		when    time.Time
+		reading string
+	}
+	tests := []struct {
+		input string
+		want  []cand
+	}{
+		{"01/02/03", []cand{
+			{time.Date(2003, 1, 2, 0, 0, 0, 0, time.UTC), "as written"},
+			{time.Date(2003, 2, 1, 0, 0, 0, 0, time.UTC), "day/month/year"},
+			{time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC), "year/month/day"},
+		}},
+		{"2023", []cand{
+			{time.Date(2024, 3, 1, 20, 23, 0, 0, time.UTC), "as written"},
+			{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), "year"},
+		}},
+		{"20240115", []cand{
+			{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "as written"},
+			{time.Unix(20240115, 0), "Unix timestamp"},
+		}},
+		{"1700000000", []cand{
+			{time.Unix(1700000000, 0), "Unix timestamp"},
+		}},
+		{"2024-01-15", []cand{
+			{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "as written"},
+		}},
+	}
+	for _, tt := range tests {
+		got, err := ParseAll(tt.input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("ParseAll(%q) error: %v", tt.input, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("ParseAll(%q) = %v, want %d candidates", tt.input, got, len(tt.want))
+			continue
+		}
+		for i, c := range got {
+			if !c.Time.Equal(tt.want[i].when) || c.Reading != tt.want[i].reading {
+				t.Errorf("ParseAll(%q)[%d] = %v %q, want %v %q", tt.input, i, c.Time, c.Reading, tt.want[i].when, tt.want[i].reading)
+			}
+			if i > 0 && c.Score > got[i-1].Score {
+				t.Errorf("ParseAll(%q) not sorted by score: %v", tt.input, got)
+			}
+		}
+	}
+
+	if _, err := ParseAll("garbage", Rel(base)); err == nil {
+		t.Error("ParseAll(\"garbage\") succeeded")
+	}
+}
+
+func TestParseAllZones(t *testing.T) {
+	if _, err := time.LoadLocation("Asia/Jerusalem"); err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	got, err := ParseAll("2024-01-15 10:00 IST")
+	if err != nil {
+		t.Fatalf("ParseAll: %v", err)
+	}
+	offsets := map[int]bool{}
+	for _, c := range got {
+		_, off := c.Time.Zone()
+		offsets[off] = true
+	}
+	for _, off := range []int{5*3600 + 1800, 2 * 3600, 0} {
+		if !offsets[off] {
+			t.Errorf("ParseAll(\"2024-01-15 10:00 IST\") = %v, missing offset %d", got, off)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatFamily is a family of formats a value can be expected in, for
+// ParseAny.
+type FormatFamily int
+
+const (
+	// FamilyAny is everything StrToTime accepts.
+	FamilyAny FormatFamily = iota
+	// FamilyISO8601 is ISO 8601 and RFC 3339 dates and times, starting
+	// with the year: "2024-01-15", "2024-01-15T10:30:00Z", "20240115T103000",
+	// "2024-W03-1".
+	FamilyISO8601
+	// FamilyRFC2822 is the dates of email and HTTP headers:
+	// "Mon, 15 Jan 2024 10:30:00 +0000".
+	FamilyRFC2822
+	// FamilyUS is numeric dates with the month first, "01/15/2024" or
+	// "1-15-24", optionally followed by a time.
+	FamilyUS
+	// FamilyEuropean is numeric dates with the day first, "15/01/2024" or
+	// "15.1.24", optionally followed by a time.
+	FamilyEuropean
+	// FamilyUnix is seconds since the Unix epoch, with an optional fraction.
+	FamilyUnix
+	// FamilyUnixMilli is milliseconds since the Unix epoch.
+	FamilyUnixMilli
+	// FamilyRelative is expressions relative to the base time, without
+	// a date of their own: "tomorrow", "next monday", "+3 days".
+	FamilyRelative
+)
+
+var formatFamilyNames = [...]string{"any", "ISO 8601", "RFC 2822", "US", "European", "Unix", "Unix milliseconds", "relative"}
+
+// String returns the name of the family.
+func (f FormatFamily) String() string {
+	if f < 0 || int(f) >= len(formatFamilyNames) {
+		return "FormatFamily(" + strconv.Itoa(int(f)) + ")"
+	}
+	return formatFamilyNames[f]
+}
+
+// FormatHint tells ParseAny what to expect of a value.
+type FormatHint struct {
+	family    FormatFamily
+	hasFamily bool
+	java      string // SimpleDateFormat pattern
+	strf      string // strftime format
+	loc       *time.Location
+	locale    string
+	opts      []Option
+}
+
+// HintFamily expects values in the family f. Several families may be
+// hinted; they are tried in order.
+func HintFamily(f FormatFamily) FormatHint {
+	return FormatHint{family: f, hasFamily: true}
+}
+
+// HintJavaFormat expects values written with a SimpleDateFormat pattern
+// (see ParseJavaFormat).
+func HintJavaFormat(pattern string) FormatHint {
+	return FormatHint{java: pattern}
+}
+
+// HintStrftime expects values written with a strftime format (see
+// ParseStrftime).
+func HintStrftime(format string) FormatHint {
+	return FormatHint{strf: format}
+}
+
+// HintZone reads values without a zone in loc, as InTZ does.
+func HintZone(loc *time.Location) FormatHint {
+	return FormatHint{loc: loc}
+}
+
+// HintLocale expects values in the language tagged tag: English, "en" or
+// "en-*", or a language with a registered Locale, such as "fr", "de" or "es".
+func HintLocale(tag string) FormatHint {
+	return FormatHint{locale: tag}
+}
+
+// HintOptions passes opts to the parsers, for the base time of relative
+// values for example.
+func HintOptions(opts ...Option) FormatHint {
+	return FormatHint{opts: opts}
+}
+
+// parseAnyStep is one way of reading the value tried by ParseAny.
+type parseAnyStep struct {
+	name  string
+	parse func(value string, opts []Option) (time.Time, error)
+}
+
+// ParseAny parses value, typically a field of a JSON or CSV record, in the
+// formats its hints allow. Hinted families, Java patterns and strftime
+// formats are tried in the order given and the first one that matches
+// wins; with none, value is read as StrToTime reads it. Narrowing the
+// grammar this way is faster and avoids misreadings: with FamilyEuropean,
+// "01/02/2024" is February 1st, and "1700000000" is only taken for a
+// timestamp with FamilyUnix.
+func ParseAny(value string, hints ...FormatHint) (time.Time, error) {
+	var opts []Option
+	var steps []parseAnyStep
+	for _, h := range hints {
+		opts = append(opts, h.opts...)
+		switch {
+		case h.loc != nil:
+			opts = append(opts, InTZ(h.loc))
+		case h.locale != "":
+			if lang := languageSubtag(h.locale); lang != "en" {
+				l, ok := locales[lang]
+				if !ok {
+					return time.Time{}, fmt.Errorf("%w: unsupported locale %q", ErrInvalidDateFormat, h.locale)
+				}
+				opts = append(opts, WithLocale(l))
+			}
+		case h.java != "":
+			pattern := h.java
+			steps = append(steps, parseAnyStep{"pattern " + strconv.Quote(pattern), func(v string, opts []Option) (time.Time, error) {
+				return ParseJavaFormat(pattern, v, opts...)
+			}})
+		case h.strf != "":
+			format := h.strf
+			steps = append(steps, parseAnyStep{"format " + strconv.Quote(format), func(v string, opts []Option) (time.Time, error) {
+				return ParseStrftime(format, v, opts...)
+			}})
+		case h.hasFamily:
+			family := h.family
+			steps = append(steps, parseAnyStep{family.String(), func(v string, opts []Option) (time.Time, error) {
+				return parseFamily(family, v, opts)
+			}})
+		}
+	}
+	if len(steps) == 0 {
+		return StrToTime(value, opts...)
+	}
+
+	value = strings.TrimSpace(value)
+	if len(steps) == 1 {
+		return steps[0].parse(value, opts)
+	}
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		if t, err := step.parse(value, opts); err == nil {
+			return t, nil
+		}
+		names[i] = step.name
+	}
+	return time.Time{}, fmt.Errorf("%w %q: expected %s", ErrInvalidDateFormat, value, strings.Join(names, " or "))
+}
+
+// parseFamily parses value as a member of family f.
+func parseFamily(f FormatFamily, value string, opts []Option) (time.Time, error) {
+	mismatch := func() (time.Time, error) {
+		return time.Time{}, fmt.Errorf("%w %q: expected %s", ErrInvalidDateFormat, value, f)
+	}
+	switch f {
+	case FamilyISO8601:
+		if !looksLikeISO8601(value) {
+			return mismatch()
+		}
+	case FamilyRFC2822:
+		t, err := mail.ParseDate(value)
+		if err != nil {
+			return mismatch()
+		}
+		return t, nil
+	case FamilyUS, FamilyEuropean:
+		return parseNumericDate(value, f == FamilyEuropean, opts)
+	case FamilyUnix, FamilyUnixMilli:
+		whole, frac, hasFrac := strings.Cut(value, ".")
+		digits := strings.TrimPrefix(whole, "-")
+		if digits == "" || !isAllDigits(digits) || (hasFrac && (f == FamilyUnixMilli || frac == "" || !isAllDigits(frac))) {
+			return mismatch()
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w %q", ErrOutOfRange, value)
+		}
+		_, loc := resolveOptions(withDefaults(opts))
+		if f == FamilyUnixMilli {
+			return time.UnixMilli(n).In(loc), nil
+		}
+		nanos := 0
+		if hasFrac {
+			frac = frac[:min(len(frac), 9)]
+			nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+			if whole[0] == '-' {
+				nanos = -nanos
+			}
+		}
+		return time.Unix(n, int64(nanos)).In(loc), nil
+	case FamilyRelative:
+		pd := DateParse(value)
+		if pd.ErrorCount > 0 || pd.Relative == nil || pd.Year.Set || pd.Month.Set || pd.Day.Set {
+			return mismatch()
+		}
+	}
+	return StrToTime(value, opts...)
+}
+
+// looksLikeISO8601 reports whether value starts like an ISO 8601 date: a
+// four-digit year followed by "-" and a digit or a "W", or eight digits
+// followed by the end of the value or a "T".
+func looksLikeISO8601(value string) bool {
+	s := strings.TrimPrefix(strings.TrimPrefix(value, "+"), "-")
+	if len(s) < 6 || !isAllDigits(s[:4]) {
+		return false
+	}
+	if s[4] == '-' {
+		return isDigit(s[5]) || s[5] == 'W' || s[5] == 'w'
+	}
+	return len(s) >= 8 && isAllDigits(s[:8]) && (len(s) == 8 || s[8] == 'T' || s[8] == 't')
+}
+
+// parseNumericDate parses a numeric date with the month first, or the day
+// first when dayFirst is set, separated by "/", "-" or ".", with a year of
+// two or four digits and an optional time after a space or a "T".
+func parseNumericDate(value string, dayFirst bool, opts []Option) (time.Time, error) {
+	family := FamilyUS
+	if dayFirst {
+		family = FamilyEuropean
+	}
+	date, clock := value, ""
+	if i := strings.IndexAny(value, " Tt"); i >= 0 {
+		date, clock = value[:i], strings.TrimSpace(value[i+1:])
+	}
+	var parts [3]string
+	sep := strings.IndexAny(date, "/-.")
+	if sep > 0 {
+		parts[0], parts[1], parts[2] = splitNumericDate(date, date[sep])
+	}
+	for i, p := range parts {
+		if p == "" || !isAllDigits(p) || (i < 2 && len(p) > 2) || (i == 2 && len(p) != 2 && len(p) != 4) {
+			return time.Time{}, fmt.Errorf("%w %q: expected %s", ErrInvalidDateFormat, value, family)
+		}
+	}
+	month, day, year := digitsValue(parts[0]), digitsValue(parts[1]), digitsValue(parts[2])
+	if dayFirst {
+		month, day = day, month
+	}
+	if len(parts[2]) == 2 {
+		year = parseTwoDigitYear(year)
+	}
+	if !IsValidDate(year, month, day) {
+		return time.Time{}, NewInvalidDateError(year, month, day)
+	}
+	return StrToTime(fmt.Sprintf("%04d-%02d-%02d %s", year, month, day, clock), opts...)
+}
+
+// splitNumericDate splits date in three at sep, or returns empty parts.
+func splitNumericDate(date string, sep byte) (string, string, string) {
+	a, rest, _ := strings.Cut(date, string(sep))
+	b, c, _ := strings.Cut(rest, string(sep))
+	return a, b, c
+}
@@ -0,0 +1,75 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseAny(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	rel := HintOptions(Rel(base))
+	utc := HintZone(time.UTC)
+	tests := []struct {
+		value string
+		hints []FormatHint
+		want  time.Time
+	}{
+		{"01/02/2024", []FormatHint{HintFamily(FamilyEuropean), utc}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"01/02/2024", []FormatHint{HintFamily(FamilyUS), utc}, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"15.1.24 14:30", []FormatHint{HintFamily(FamilyEuropean), utc}, time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC)},
+		{"2024-01-15T10:30:00Z", []FormatHint{HintFamily(FamilyISO8601)}, time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{"2024-01-15 10:30", []FormatHint{HintFamily(FamilyISO8601), HintZone(paris)}, time.Date(2024, 1, 15, 10, 30, 0, 0, paris)},
+		{"Mon, 15 Jan 2024 10:30:00 +0000", []FormatHint{HintFamily(FamilyRFC2822)}, time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{"1700000000", []FormatHint{HintFamily(FamilyUnix)}, time.Unix(1700000000, 0)},
+		{"1700000000.25", []FormatHint{HintFamily(FamilyUnix)}, time.Unix(1700000000, 250000000)},
+		{"-1.5", []FormatHint{HintFamily(FamilyUnix)}, time.Unix(-1, -500000000)},
+		{"1700000000123", []FormatHint{HintFamily(FamilyUnixMilli)}, time.UnixMilli(1700000000123)},
+		{"next monday", []FormatHint{HintFamily(FamilyRelative), rel}, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"1700000000", []FormatHint{HintFamily(FamilyISO8601), HintFamily(FamilyUnix)}, time.Unix(1700000000, 0)},
+		{"15/01/2024", []FormatHint{HintJavaFormat("yyyy-MM-dd"), HintStrftime("%d/%m/%Y"), utc}, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"tomorrow", []FormatHint{rel, HintLocale("en-GB")}, time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"demain à midi", []FormatHint{rel, HintLocale("fr-CA")}, time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := ParseAny(tt.value, tt.hints...)
+		if err != nil {
+			t.Errorf("ParseAny(%q) error: %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseAny(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseAnyMismatch(t *testing.T) {
+	tests := []struct {
+		value string
+		hints []FormatHint
+	}{
+		{"1700000000", []FormatHint{HintFamily(FamilyISO8601)}},
+		{"2024-01-15", []FormatHint{HintFamily(FamilyUnix)}},
+		{"2024/01/15", []FormatHint{HintFamily(FamilyUS)}},
+		{"13/01/2024", []FormatHint{HintFamily(FamilyUS)}},
+		{"2024-01-15", []FormatHint{HintFamily(FamilyRelative)}},
+		{"next monday", []FormatHint{HintFamily(FamilyRFC2822), HintFamily(FamilyUS)}},
+		{"tomorrow", []FormatHint{HintLocale("ja")}},
+	}
+	for _, tt := range tests {
+		got, err := ParseAny(tt.value, tt.hints...)
+		if err == nil {
+			t.Errorf("ParseAny(%q) = %v, want an error", tt.value, got)
+			continue
+		}
+		if !errors.Is(err, ErrInvalidDateFormat) && !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("ParseAny(%q) error = %v, want ErrInvalidDateFormat", tt.value, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package strtotime
+
+import "time"
+
+// ParseEvent describes one parse, for the hooks registered with OnParse.
+type ParseEvent struct {
+	Input string
+	// Format names the format or pipeline stage that matched, such as
+	// "iso8601", "us_date", "keyword" or "grammar" (the general parser of
+	// relative expressions); it is empty when the parse failed.
+	Format   string
+	Duration time.Duration
+	Err      error
+}
+
+// OnParse registers fn to be called after each StrToTime call, successful
+// or not, with the format that matched and the time taken, so that services
+// can monitor the formats their traffic contains without wrapping every
+// call. Passed to SetDefaultOptions, it observes every call of the package,
+// including those made through ParserBuffer, LineParser and the functions
+// built on StrToTime; results served from a Cache without parsing are not
+// reported. Several hooks may be registered. fn runs synchronously on the
+// parsing goroutine and must be safe for concurrent use.
+func OnParse(fn func(ParseEvent)) Option {
+	return parseHookOption{fn: fn}
+}
+
+// parseHookOption is an internal type for the OnParse option
+type parseHookOption struct {
+	fn func(ParseEvent)
+}
+
+func (parseHookOption) isOption() bool {
+	return true
+}
+
+// parseHooks returns the hooks registered in opts.
+func parseHooks(opts []Option) []func(ParseEvent) {
+	var hooks []func(ParseEvent)
+	for _, opt := range opts {
+		if h, ok := opt.(parseHookOption); ok && h.fn != nil {
+			hooks = append(hooks, h.fn)
+		}
+	}
+	return hooks
+}
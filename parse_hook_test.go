@@ -0,0 +1,56 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOnParse(t *testing.T) {
+	var events []ParseEvent
+	hook := OnParse(func(ev ParseEvent) { events = append(events, ev) })
+	base := Rel(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		input, format string
+		fails         bool
+	}{
+		{"2024-01-15T10:30:00Z", "iso8601", false},
+		{"2024-01-15", "iso_date", false},
+		{"tomorrow", "keyword", false},
+		{"@1700000000", "unix_timestamp", false},
+		{"next monday", "grammar", false},
+		{"garbage", "", true},
+	}
+	for _, tt := range tests {
+		events = events[:0]
+		StrToTime(tt.input, base, hook)
+		if len(events) != 1 {
+			t.Errorf("StrToTime(%q) fired %d events, want 1", tt.input, len(events))
+			continue
+		}
+		ev := events[0]
+		if ev.Input != tt.input || ev.Format != tt.format || (ev.Err != nil) != tt.fails || ev.Duration <= 0 {
+			t.Errorf("StrToTime(%q) event = %+v, want format %q", tt.input, ev, tt.format)
+		}
+	}
+
+	// A Cache reports the parse it makes on a miss.
+	events = events[:0]
+	NewCache(8).StrToTime("2024-01-15", base, hook)
+	if len(events) != 1 {
+		t.Errorf("Cache.StrToTime fired %d events, want 1", len(events))
+	}
+}
+
+func TestOnParseDefault(t *testing.T) {
+	n := 0
+	SetDefaultOptions(OnParse(func(ParseEvent) { n++ }))
+	defer SetDefaultOptions()
+
+	var buf ParserBuffer
+	StrToTime("2024-01-15")
+	buf.StrToTime("tomorrow")
+	if n != 2 {
+		t.Errorf("default hook fired %d times, want 2", n)
+	}
+}
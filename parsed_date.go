@@ -3,6 +3,7 @@ package strtotime
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -51,6 +52,37 @@ type ParsedDate struct {
 	// even when the input has no "." fractional separator. Bare HHMM inputs
 	// leave fraction=false.
 	fractionDefaultsZero bool
+
+	// Scratch space reused across parses when the ParsedDate is owned by a
+	// ParserBuffer: the token slice of the token parser with the keyword
+	// kinds of its tokens, and the sub-result handed to each formatParsers
+	// entry. Relative points to rel once set, so that resetting the
+	// ParsedDate keeps its storage too.
+	tokens  []Token
+	kinds   []tokenKind
+	scratch *ParsedDate
+	rel     Relative
+
+	// cfg holds the behavior options the input was parsed with, for the
+	// arithmetic done by Materialize.
+	cfg parseSettings
+
+	// cause is the typed error behind a failed parse, when there is one.
+	cause error
+
+	// leftover is the end of the input a parser matched without reading.
+	// Such a match is only kept under the Lenient option; otherwise the
+	// shortest leftover of the rejected matches is kept in unread for the
+	// error.
+	leftover string
+	unread   string
+
+	// format names the pipeline stage or formatParsers entry that matched.
+	format string
+
+	// dayDefaulted records that Day holds the 1st PHP fills in for a month
+	// and year without a day ("January 2024", "2024-01"), for ParseDetailed.
+	dayDefaulted bool
 }
 
 // Relative captures the relative-time portion of a parsed expression.
@@ -115,6 +147,51 @@ func newParsedDate() *ParsedDate {
 	}
 }
 
+// reset clears pd for a new parse while keeping its allocated storage.
+func (pd *ParsedDate) reset() {
+	warnings, errs := pd.Warnings, pd.Errors
+	clear(warnings)
+	clear(errs)
+	*pd = ParsedDate{
+		Warnings: warnings,
+		Errors:   errs,
+		tokens:   pd.tokens[:0],
+		kinds:    pd.kinds[:0],
+		scratch:  pd.scratch,
+	}
+}
+
+// subParse returns an empty ParsedDate for a formatParsers entry to fill,
+// reusing the one from the previous attempt when possible.
+func (pd *ParsedDate) subParse() *ParsedDate {
+	if pd.scratch == nil {
+		pd.scratch = newParsedDate()
+	} else {
+		pd.scratch.reset()
+	}
+	return pd.scratch
+}
+
+// noteUnread keeps text as the unread text to report if the parse fails,
+// preferring the shortest one: it comes from the match that read the most.
+func (pd *ParsedDate) noteUnread(text string) {
+	if text != "" && (pd.unread == "" || len(text) < len(pd.unread)) {
+		pd.unread = text
+	}
+}
+
+// hasNamedZone reports whether the input carried a timezone name or
+// abbreviation, as opposed to a numeric offset or the ISO 8601 "Z" suffix.
+func (pd *ParsedDate) hasNamedZone() bool {
+	switch pd.ZoneType {
+	case 2:
+		return pd.TzAbbr != "Z"
+	case 3:
+		return true
+	}
+	return false
+}
+
 // SetDate records year/month/day from a parsed absolute date.
 func (pd *ParsedDate) SetDate(year, month, day int) {
 	pd.Year = OptInt{V: year, Set: true}
@@ -197,7 +274,8 @@ func (pd *ParsedDate) SetTZIdentifier(loc *time.Location, id string) {
 // relative returns the Relative block, creating it on first use.
 func (pd *ParsedDate) relative() *Relative {
 	if pd.Relative == nil {
-		pd.Relative = &Relative{}
+		pd.rel = Relative{}
+		pd.Relative = &pd.rel
 	}
 	return pd.Relative
 }
@@ -213,6 +291,8 @@ func (pd *ParsedDate) AddRelative(unit string, amount int) {
 		r.Month += amount
 	case UnitWeek:
 		r.Day += amount * 7
+	case UnitFortnight:
+		r.Day += amount * 14
 	case UnitDay:
 		r.Day += amount
 	case UnitHour:
@@ -265,6 +345,27 @@ func (pd *ParsedDate) setMaterialized(t time.Time) {
 	pd.hasMaterialized = true
 }
 
+// independentOfNow reports whether pd materializes to the same time for any
+// reference time: one of absoluteFormats read it, and it holds a full date.
+func (pd *ParsedDate) independentOfNow() bool {
+	return absoluteFormats[pd.format] && pd.Year.Set && pd.Month.Set && pd.Day.Set
+}
+
+// absoluteFormats are the formatParsers entries that read the date of their
+// input from the input alone, never from the reference time.
+var absoluteFormats = map[string]bool{
+	"iso_date":          true,
+	"iso8601":           true,
+	"date_time":         true,
+	"day_month_year":    true,
+	"us_date":           true,
+	"european_date":     true,
+	"compact_timestamp": true,
+	"unix_timestamp":    true,
+	"rfc2822":           true,
+	"asctime":           true,
+}
+
 // Materialize renders the ParsedDate into a time.Time. Unset components fall
 // back to the corresponding field of now. Relative offsets are applied after
 // the base date is assembled. Returns an error if the extracted date or time
@@ -332,14 +433,16 @@ func (pd *ParsedDate) Materialize(now time.Time, loc *time.Location) (time.Time,
 	}
 
 	if pd.Relative != nil {
-		t = applyRelative(t, pd.Relative, effectiveLoc)
+		return applyRelative(t, pd.Relative, effectiveLoc, pd.cfg)
 	}
 
 	return t, nil
 }
 
-// applyRelative applies a Relative block to a base time.
-func applyRelative(t time.Time, r *Relative, loc *time.Location) time.Time {
+// applyRelative applies a Relative block to a base time, adding months,
+// years and days according to the policies of cfg. It fails only when the
+// MonthError policy rejects the month arithmetic.
+func applyRelative(t time.Time, r *Relative, loc *time.Location, cfg parseSettings) (time.Time, error) {
 	// Order matches PHP timelib: firstLastDayOf first, then relative units,
 	// then weekday snap.
 	if r.firstLastDayMode != 0 {
@@ -356,7 +459,7 @@ func applyRelative(t time.Time, r *Relative, loc *time.Location) time.Time {
 		t = time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
 		// Non-month/year units still apply below via the remaining unit offsets.
 		if r.Day != 0 {
-			t = applyTimeOffset(t, r.Day, UnitDay)
+			t = addDays(t, r.Day, cfg.days)
 		}
 		if r.Hour != 0 {
 			t = applyTimeOffset(t, r.Hour, UnitHour)
@@ -368,14 +471,16 @@ func applyRelative(t time.Time, r *Relative, loc *time.Location) time.Time {
 			t = applyTimeOffset(t, r.Second, UnitSecond)
 		}
 	} else {
-		if r.Year != 0 {
-			t = applyTimeOffset(t, r.Year, UnitYear)
-		}
-		if r.Month != 0 {
-			t = applyTimeOffset(t, r.Month, UnitMonth)
+		// Years and months are added together, as PHP does, so that
+		// "+1 year +1 month" from Feb 29 doesn't roll over twice.
+		if n := r.Year*12 + r.Month; n != 0 {
+			if err := monthOverflow(t, n, cfg.months); err != nil {
+				return time.Time{}, err
+			}
+			t = addMonths(t, n, cfg.months)
 		}
 		if r.Day != 0 {
-			t = applyTimeOffset(t, r.Day, UnitDay)
+			t = addDays(t, r.Day, cfg.days)
 		}
 		if r.Hour != 0 {
 			t = applyTimeOffset(t, r.Hour, UnitHour)
@@ -397,7 +502,7 @@ func applyRelative(t time.Time, r *Relative, loc *time.Location) time.Time {
 		t = t.AddDate(0, 0, delta)
 	}
 
-	return t
+	return t, nil
 }
 
 // MarshalJSON produces PHP date_parse-compatible JSON. Field order matches
@@ -571,13 +676,43 @@ func (pd *ParsedDate) firstError() error {
 	if pd.ErrorCount == 0 {
 		return nil
 	}
-	keys := make([]int, 0, len(pd.Errors))
+	if pos, msg := pd.firstErrorPos(); pos >= 0 {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("parse error")
+}
+
+// firstErrorPos returns the position and message of the first error, by
+// position.
+func (pd *ParsedDate) firstErrorPos() (int, string) {
+	pos := -1
 	for k := range pd.Errors {
-		keys = append(keys, k)
+		if pos < 0 || k < pos {
+			pos = k
+		}
 	}
-	sort.Ints(keys)
-	if len(keys) == 0 {
-		return fmt.Errorf("parse error")
+	return pos, pd.Errors[pos]
+}
+
+// tokenAt returns the text of the token at byte offset pos of the input, or
+// "" when the input was not tokenized.
+func (pd *ParsedDate) tokenAt(pos int) string {
+	for _, tok := range pd.tokens {
+		if pos >= tok.Pos && pos < tok.Pos+len(tok.Val) {
+			return tok.Val
+		}
+	}
+	return ""
+}
+
+// firstWarning returns the position and message of the first warning, by
+// position.
+func (pd *ParsedDate) firstWarning() (int, string) {
+	pos := -1
+	for k := range pd.Warnings {
+		if pos < 0 || k < pos {
+			pos = k
+		}
 	}
-	return fmt.Errorf("%s", pd.Errors[keys[0]])
+	return pos, pd.Warnings[pos]
 }
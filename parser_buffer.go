@@ -0,0 +1,31 @@
+package strtotime
+
+import "time"
+
+// ParserBuffer holds scratch space that is reused from one parse to the next,
+// for callers that parse large volumes of input such as log processors. Its
+// StrToTime method behaves exactly like the package-level StrToTime.
+//
+// Numeric dates and times, @timestamps, "15 jan 2024" and the common relative
+// expressions, such as "tomorrow", "next monday", "+1 week 2 days" or "first
+// day of next month", parse without allocating. An input with upper-case
+// letters allocates once, for its lowercase copy. Other formats, notably
+// those naming a time zone, still allocate.
+//
+// The zero value is ready to use. A ParserBuffer must not be used by several
+// goroutines at once; give each worker its own buffer, or keep them in a
+// sync.Pool.
+type ParserBuffer struct {
+	pd   ParsedDate
+	opts []Option
+}
+
+// StrToTime parses str like the package-level StrToTime, reusing the buffer's
+// storage instead of allocating fresh parser state.
+func (b *ParserBuffer) StrToTime(str string, opts ...Option) (time.Time, error) {
+	b.pd.reset()
+	// The parsers keep hold of the options they get, so handing them a
+	// copy lets the caller's variadic slice stay on its stack.
+	b.opts = append(b.opts[:0], withDefaults(opts)...)
+	return strToTime(str, b.opts, &b.pd)
+}
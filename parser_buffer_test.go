@@ -0,0 +1,66 @@
+package strtotime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParserBuffer(t *testing.T) {
+	var buf ParserBuffer
+	for _, rec := range loadCSV(t, "testdata/strtotime_tests.csv") {
+		var opts []Option
+		if base, _ := strconv.ParseInt(rec[1], 10, 64); base != 0 {
+			opts = append(opts, Rel(time.Unix(base, 0).UTC()))
+		}
+		if rec[2] != "" {
+			loc, err := csvLoadLocation(rec[2])
+			if err != nil {
+				t.Fatalf("bad timezone %q: %v", rec[2], err)
+			}
+			opts = append(opts, InTZ(loc))
+		}
+		want, wantErr := StrToTime(rec[0], opts...)
+		got, err := buf.StrToTime(rec[0], opts...)
+		if (err != nil) != (wantErr != nil) || !got.Equal(want) {
+			t.Errorf("ParserBuffer.StrToTime(%q) = %v, %v; want %v, %v", rec[0], got, err, want, wantErr)
+		}
+	}
+}
+
+func TestParserBufferAllocs(t *testing.T) {
+	// Boxed once here, so that the calls below don't count it.
+	var base Option = Rel(time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	var buf ParserBuffer
+	tests := []struct {
+		input string
+		max   float64
+	}{
+		{"2024-01-15", 0},
+		{"2024-01-15 10:30:00", 0},
+		{"01/15/2024", 0},
+		{"10:30:45", 0},
+		{"@1700000000", 0},
+		{"15 jan 2024", 0},
+		{"now", 0},
+		{"tomorrow", 0},
+		{"noon", 0},
+		{"monday", 0},
+		{"next monday", 0},
+		{"+1 week 2 days", 0},
+		{"-2 weeks", 0},
+		{"3 days ago", 0},
+		{"first day of next month", 0},
+		{"10am", 0},
+		// The lowercase copy of the input.
+		{"2024-01-15T10:30:00Z", 1},
+	}
+	for _, tt := range tests {
+		if _, err := buf.StrToTime(tt.input, base); err != nil {
+			t.Fatalf("StrToTime(%q): %v", tt.input, err)
+		}
+		if n := testing.AllocsPerRun(50, func() { _, _ = buf.StrToTime(tt.input, base) }); n > tt.max {
+			t.Errorf("%q: ParserBuffer allocated %v times, want at most %v", tt.input, n, tt.max)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// parsePeriodBoundary parses the start or end of a week, month, quarter or
+// year: "start of week", "beginning of next month", "end of last year". The
+// period is the current one unless "this", "next", "last" or "previous"
+// picks another. Starts are midnight on the first day, ends 23:59:59 on the
+// last one. Weeks start on weekStart (see WeekStartsOn).
+//
+// Fiscal years and quarters, which start in fiscalStart (see
+// FiscalYearStart), are accepted the same way ("start of fiscal year", "end
+// of next fiscal quarter") or by name ("end of FY2024", "start of FY24 Q3").
+func parsePeriodBoundary(str string, now time.Time, loc *time.Location, weekStart time.Weekday, fiscalStart time.Month) (time.Time, bool) {
+	var buf [12]Token
+	c := newTokenCursor(str, buf[:])
+
+	edge, _ := c.word()
+	if edge != "start" && edge != "beginning" && edge != "end" {
+		return time.Time{}, false
+	}
+	if w, _ := c.word(); w != "of" {
+		return time.Time{}, false
+	}
+	// The rest is sliced from str rather than c, which keeps buf on the stack.
+	rest := str[len(str)-len(c.rest()):]
+	if first, next, ok := parseFiscalPeriod(appendFields(make([]string, 0, fieldBufLen), rest), fiscalStart, loc); ok {
+		return periodEdge(edge, first, next, loc), true
+	}
+	shift := 0
+	switch w, _ := c.peekWord(); w {
+	case DirectionNext:
+		shift = 1
+	case DirectionLast, "previous":
+		shift = -1
+	}
+	if w, _ := c.peekWord(); w == "this" || shift != 0 {
+		c.word()
+	}
+	unit, _ := c.word()
+	yearStart := time.January
+	if unit == "fiscal" {
+		unit, _ = c.word()
+		if unit != UnitYear && unit != "quarter" {
+			return time.Time{}, false
+		}
+		yearStart = fiscalStart
+	}
+	if !c.done() {
+		return time.Time{}, false
+	}
+
+	now = now.In(loc)
+	// First day of the period and of the one after it, at noon so that DST
+	// changes don't move them to another day.
+	var first, next time.Time
+	switch unit {
+	case UnitWeek:
+		y, m, d := weekStartDate(now, weekStart)
+		first = time.Date(y, m, d+7*shift, 12, 0, 0, 0, loc)
+		next = first.AddDate(0, 0, 7)
+	case UnitMonth:
+		first = time.Date(now.Year(), now.Month()+time.Month(shift), 1, 12, 0, 0, 0, loc)
+		next = first.AddDate(0, 1, 0)
+	case "quarter":
+		first = fiscalPeriodStart(now, 3, yearStart, loc).AddDate(0, 3*shift, 0)
+		next = first.AddDate(0, 3, 0)
+	case UnitYear:
+		first = fiscalPeriodStart(now, 12, yearStart, loc).AddDate(shift, 0, 0)
+		next = first.AddDate(1, 0, 0)
+	default:
+		return time.Time{}, false
+	}
+	return periodEdge(edge, first, next, loc), true
+}
+
+// periodEdge returns the start of a period running from the day of first up
+// to the day of next, or its end when edge is "end".
+func periodEdge(edge string, first, next time.Time, loc *time.Location) time.Time {
+	if edge == "end" {
+		y, m, d := next.Date()
+		return endOfDate(y, m, d-1, loc)
+	}
+	y, m, d := first.Date()
+	return startOfDate(y, m, d, loc)
+}
+
+func parsePeriodBoundaryInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parsePeriodBoundary(str, now, loc, pd.cfg.weekStart, fiscalYearStart(opts))
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,54 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodBoundary(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC) // a Friday
+	tests := map[string]time.Time{
+		"start of week":           time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+		"end of week":             time.Date(2024, 3, 3, 23, 59, 59, 0, time.UTC),
+		"start of next week":      time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		"beginning of last month": time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		"end of the month":        time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC),
+		"end of next month":       time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC),
+		"start of this year":      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"end of previous year":    time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	got, err := StrToTime("start of week", Rel(base), WeekStartsOn(time.Sunday))
+	if want := time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("StrToTime(\"start of week\") with weeks starting on Sunday = %v, %v, want %v", got, err, want)
+	}
+
+	// The exported helpers agree with the parser.
+	for input, fn := range map[string]func(time.Time, ...Option) time.Time{
+		"start of week":    StartOfWeek,
+		"end of week":      EndOfWeek,
+		"end of month":     EndOfMonth,
+		"start of quarter": StartOfQuarter,
+		"end of year":      EndOfYear,
+	} {
+		opts := []Option{Rel(base), WeekStartsOn(time.Saturday)}
+		got, err := StrToTime(input, opts...)
+		if want := fn(base, opts...); err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{"start of day", "end of week 2024", "start week"} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
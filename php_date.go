@@ -0,0 +1,151 @@
+package strtotime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatPHP formats t like PHP's date(): each format character is replaced
+// by the matching part of t ("Y-m-d H:i:s" gives "2024-06-14 09:30:00"),
+// other characters are copied, and a backslash copies the character after
+// it as is.
+func FormatPHP(format string, t time.Time) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c == '\\' {
+			if i+1 < len(format) {
+				i++
+				b.WriteByte(format[i])
+			}
+			continue
+		}
+
+		switch c {
+		// Day
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'D':
+			b.WriteString(t.Format("Mon"))
+		case 'j':
+			b.WriteString(strconv.Itoa(t.Day()))
+		case 'l':
+			b.WriteString(t.Weekday().String())
+		case 'N':
+			b.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'S':
+			b.WriteString(ordinalSuffix(t.Day()))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'z':
+			b.WriteString(strconv.Itoa(t.YearDay() - 1))
+
+		// Week
+		case 'W':
+			_, week := t.ISOWeek()
+			b.WriteString(pad2(week))
+
+		// Month
+		case 'F':
+			b.WriteString(t.Month().String())
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'M':
+			b.WriteString(t.Format("Jan"))
+		case 'n':
+			b.WriteString(strconv.Itoa(int(t.Month())))
+		case 't':
+			b.WriteString(strconv.Itoa(time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()))
+
+		// Year
+		case 'L':
+			if time.Date(t.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366 {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		case 'o':
+			year, _ := t.ISOWeek()
+			b.WriteString(strconv.Itoa(year))
+		case 'Y':
+			b.WriteString(t.Format("2006"))
+		case 'y':
+			b.WriteString(t.Format("06"))
+
+		// Time
+		case 'a':
+			b.WriteString(t.Format("pm"))
+		case 'A':
+			b.WriteString(t.Format("PM"))
+		case 'B':
+			// Swatch Internet time: thousandths of a day in UTC+1.
+			sec := (t.Unix() + 3600) % 86400
+			if sec < 0 {
+				sec += 86400
+			}
+			b.WriteString(strconv.FormatInt(1000+sec*10/864, 10)[1:])
+		case 'g':
+			b.WriteString(t.Format("3"))
+		case 'G':
+			b.WriteString(strconv.Itoa(t.Hour()))
+		case 'h':
+			b.WriteString(t.Format("03"))
+		case 'H':
+			b.WriteString(t.Format("15"))
+		case 'i':
+			b.WriteString(t.Format("04"))
+		case 's':
+			b.WriteString(t.Format("05"))
+		case 'u':
+			b.WriteString(t.Format(".000000")[1:])
+		case 'v':
+			b.WriteString(t.Format(".000")[1:])
+
+		// Timezone
+		case 'e':
+			b.WriteString(t.Location().String())
+		case 'I':
+			if t.IsDST() {
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		case 'O':
+			b.WriteString(t.Format("-0700"))
+		case 'P':
+			b.WriteString(t.Format("-07:00"))
+		case 'p':
+			if _, offset := t.Zone(); offset == 0 {
+				b.WriteByte('Z')
+			} else {
+				b.WriteString(t.Format("-07:00"))
+			}
+		case 'T':
+			b.WriteString(t.Format("MST"))
+		case 'Z':
+			_, offset := t.Zone()
+			b.WriteString(strconv.Itoa(offset))
+
+		// Full date/time
+		case 'c':
+			b.WriteString(t.Format("2006-01-02T15:04:05-07:00"))
+		case 'r':
+			b.WriteString(t.Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+		case 'U':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// pad2 formats n with at least two digits.
+func pad2(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,42 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatPHP(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("Europe/Paris not available")
+	}
+	tm := time.Date(2024, time.June, 1, 13, 5, 9, 123456000, paris)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"Y-m-d H:i:s", "2024-06-01 13:05:09"},
+		{"D, j M y", "Sat, 1 Jun 24"},
+		{"l jS \\of F", "Saturday 1st of June"},
+		{"N w z t L", "6 6 152 30 1"},
+		{"W o", "22 2024"},
+		{"g:i a, h A, G", "1:05 pm, 01 PM, 13"},
+		{"u v", "123456 123"},
+		{"e T O P p Z I", "Europe/Paris CEST +0200 +02:00 +02:00 7200 1"},
+		{"B", "503"},
+		{"c", "2024-06-01T13:05:09+02:00"},
+		{"r", "Sat, 01 Jun 2024 13:05:09 +0200"},
+		{"U", "1717239909"},
+		{"\\Y\\-m", "Y-06"},
+	}
+	for _, tt := range tests {
+		if got := FormatPHP(tt.format, tm); got != tt.want {
+			t.Errorf("FormatPHP(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	if got := FormatPHP("p", tm.UTC()); got != "Z" {
+		t.Errorf("FormatPHP(\"p\") in UTC = %q, want \"Z\"", got)
+	}
+}
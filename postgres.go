@@ -0,0 +1,74 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// cutDottedYMD reads the PostgreSQL date "2023.01.15", year first with
+// periods between the fields, at the start of str, and returns it written
+// "2023-01-15" along with the rest of str. The date must be followed by the
+// end of str or a space.
+func cutDottedYMD(str string) (date, rest string, ok bool) {
+	field, rest, _ := strings.Cut(str, " ")
+	year, month, day, ok := splitDigitFields(field, '.')
+	if !ok || len(year) != 4 || len(month) > 2 || len(day) > 2 {
+		return "", "", false
+	}
+	if !IsValidDate(digitsValue(year), digitsValue(month), digitsValue(day)) {
+		return "", "", false
+	}
+	return year + "-" + month + "-" + day, rest, true
+}
+
+// dispatchPostgresDate parses the date rewritten by parsePostgresDateInto,
+// assigned in init because dispatchStrToTime reaches parsePostgresDateInto
+// through formatParsers.
+var dispatchPostgresDate func(string, time.Time, *time.Location, []Option, *ParsedDate) bool
+
+func init() {
+	dispatchPostgresDate = dispatchStrToTime
+}
+
+// parsePostgresDateInto parses PostgreSQL's dotted dates, "2023.01.15",
+// optionally followed by anything that may follow "2023-01-15", such as a
+// time: "2023.01.15 10:30".
+func parsePostgresDateInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	date, rest, ok := cutDottedYMD(str)
+	if !ok {
+		return false
+	}
+	if rest != "" {
+		date += " " + rest
+	}
+	return dispatchPostgresDate(date, now, loc, opts, pd)
+}
+
+// isPostgresLiteral reports whether str is one of the special values read
+// by parsePostgresLiteralInto, which lets them through implausibleInput.
+func isPostgresLiteral(str string) bool {
+	return str == "epoch" || str == "allballs"
+}
+
+// parsePostgresLiteralInto parses the special values of PostgreSQL's date
+// and time types: "epoch", 1970-01-01 00:00:00 UTC, and "allballs", the
+// time 00:00:00 UTC, today in UTC.
+func parsePostgresLiteralInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	if !isPostgresLiteral(str) {
+		return false
+	}
+	t := time.Unix(0, 0).UTC()
+	switch str {
+	case "epoch":
+		pd.SetDate(1970, 1, 1)
+	case "allballs":
+		y, m, d := now.In(time.UTC).Date()
+		t = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	pd.SetTime(0, 0, 0)
+	setTZFromLocation(pd, time.UTC, t)
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,40 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPostgresInputs(t *testing.T) {
+	base := time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*3600)
+	tests := map[string]time.Time{
+		"2023.01.15":                time.Date(2023, 1, 15, 0, 0, 0, 0, tokyo),
+		"2023.1.5":                  time.Date(2023, 1, 5, 0, 0, 0, 0, tokyo),
+		"2023.01.15 10:30":          time.Date(2023, 1, 15, 10, 30, 0, 0, tokyo),
+		"2023.01.15 10:30:45 +0200": time.Date(2023, 1, 15, 8, 30, 45, 0, time.UTC),
+		"2023.01.15 +1 day":         time.Date(2023, 1, 16, 0, 0, 0, 0, tokyo),
+		"epoch":                     time.Unix(0, 0),
+		"EPOCH":                     time.Unix(0, 0),
+		// 22:00 UTC is already March 2nd in Tokyo; allballs is read in UTC.
+		"allballs": time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base), InTZ(tokyo))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"2023.02.30", "2023.13.01", "epochs"} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package strtotime
+
+// maxInputLen is the longest input StrToTime attempts to parse. Real date
+// expressions, even long chains of relative terms, are far shorter.
+const maxInputLen = 512
+
+// implausibleInput reports whether the normalized input str cannot possibly
+// be a date, so that StrToTime can fail without walking the whole pipeline.
+// Inputs are rejected when they are overlong, contain control bytes, or have
+// neither a digit nor a single word any stage could recognize.
+func implausibleInput(str string) bool {
+	if len(str) > maxInputLen {
+		return true
+	}
+
+	hasDigit := false
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if (c < 0x20 && !isSpaceByte(c)) || c == 0x7f {
+			return true
+		}
+		if c >= '0' && c <= '9' {
+			hasDigit = true
+		}
+	}
+	if hasDigit {
+		return false
+	}
+
+	for i := 0; i < len(str); {
+		for i < len(str) && !isLetterByte(str[i]) {
+			i++
+		}
+		start := i
+		for i < len(str) && isLetterByte(str[i]) {
+			i++
+		}
+		if start < i && (isKnownWord(str[start:i]) || isHolidayWord(str[start:i])) {
+			return false
+		}
+	}
+
+	// Zone names ("europe/paris", "japan") and PostgreSQL's "epoch" and
+	// "allballs" are the remaining digit-free inputs that parse on their own.
+	if isPostgresLiteral(str) {
+		return false
+	}
+	_, ok := tryParseTimezone(str)
+	return !ok
+}
+
+// isKnownWord reports whether word is a keyword, month, weekday, unit,
+// ordinal or zone abbreviation.
+func isKnownWord(word string) bool {
+	return classifyWord(word) != 0 || word == "previous"
+}
+
+// isLetterByte reports whether c is an ASCII letter or part of a multi-byte
+// UTF-8 sequence (registered month names may be non-ASCII).
+func isLetterByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
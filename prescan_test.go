@@ -0,0 +1,50 @@
+package strtotime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImplausibleInput(t *testing.T) {
+	plausible := []string{
+		"now", "noon", "next monday", "first day of next month", "est",
+		"europe/paris", "japan", "eastern time", "x", "2024-01-15",
+		"@1700000000", "jan", "+1 week",
+	}
+	for _, input := range plausible {
+		if implausibleInput(input) {
+			t.Errorf("implausibleInput(%q) = true, want false", input)
+		}
+	}
+
+	junk := []string{
+		"hello world", "lorem ipsum dolor sit amet", "foo\x00bar",
+		"2024-01-15\x01", strings.Repeat("1", maxInputLen+1),
+	}
+	for _, input := range junk {
+		if !implausibleInput(input) {
+			t.Errorf("implausibleInput(%q) = false, want true", input)
+		}
+	}
+}
+
+// TestImplausibleInputConsistency checks that the pre-scan only rejects
+// inputs the full pipeline would have rejected anyway.
+func TestImplausibleInputConsistency(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	inputs := []string{"hello world", "lorem ipsum dolor sit amet", "foo\x00bar", "xyzzy", "qwerty uiop"}
+	for _, rec := range loadCSV(t, "testdata/strtotime_invalid.csv") {
+		inputs = append(inputs, rec[0])
+	}
+	for _, input := range inputs {
+		str := collapseSpaces(normalizeInput(input))
+		if str == "" || !implausibleInput(str) {
+			continue
+		}
+		pd := newParsedDate()
+		if dispatchStrToTime(str, base, time.UTC, nil, pd) && pd.ErrorCount == 0 {
+			t.Errorf("implausibleInput(%q) = true but the pipeline accepts it", str)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrenceAliases are the one-word recurrences and the phrases they
+// stand for.
+var recurrenceAliases = map[string]string{
+	"hourly":   "every hour",
+	"daily":    "every day",
+	"weekly":   "every week",
+	"monthly":  "every month",
+	"yearly":   "every year",
+	"annually": "every year",
+}
+
+// cronRange returns the set of the values from lo to hi, every step.
+func cronRange(lo, hi, step int) uint64 {
+	var set uint64
+	for v := lo; v <= hi; v += step {
+		set |= 1 << v
+	}
+	return set
+}
+
+// everyDay is the Cron matching every day at midnight, from which the
+// recurrences are built.
+var everyDay = Cron{
+	second: 1, minute: 1, hour: 1,
+	dom: cronRange(1, 31, 1), month: cronRange(1, 12, 1), dow: cronRange(0, 6, 1),
+	domAny: true, dowAny: true,
+}
+
+// weekdays and weekendDays are the days of the week matched by "every
+// weekday" and "every weekend".
+var (
+	weekdays    = cronRange(1, 5, 1)
+	weekendDays = uint64(1<<time.Sunday | 1<<time.Saturday)
+)
+
+// ParseRecurrence converts a recurrence written in English into a Cron,
+// for tools that store schedules in cron syntax. It understands:
+//
+//   - "every second", "every 10 seconds", "every minute", "every 15
+//     minutes", "every hour", "every 2 hours" and "every hour at minute 30",
+//     where counts of seconds and minutes divide 60 and counts of hours 24,
+//     so that the gaps stay even across the minute, hour or day
+//   - "every day", "every weekday", "every weekend", "every monday", "every
+//     tuesday and thursday", "every week on friday", "every month on the
+//     1st and 15th" and "every year on july 4th"
+//   - "hourly", "daily", "weekly", "monthly", "yearly" and "annually"
+//
+// Days, weeks, months and years recur at midnight unless "at" gives a time:
+// "every weekday at 9am", "every day at 9:30 and 17:30". Several times must
+// share their minutes, as cron only allows one list of hours and one of
+// minutes. Weeks start on Sunday and months on the 1st, as in cron's
+// @weekly and @monthly.
+func ParseRecurrence(phrase string) (*Cron, error) {
+	text := strings.ToLower(strings.TrimSpace(phrase))
+	if alias, ok := recurrenceAliases[text]; ok {
+		text = alias
+	}
+	fields := strings.Fields(strings.ReplaceAll(text, ",", " "))
+	fail := func(reason string) (*Cron, error) {
+		return nil, fmt.Errorf("%w %q: %s", ErrInvalidSchedule, phrase, reason)
+	}
+	if len(fields) < 2 || fields[0] != "every" {
+		return fail(`expected "every"`)
+	}
+	fields = fields[1:]
+
+	n := 1
+	if isAllDigits(fields[0]) && len(fields[0]) <= 2 {
+		n, fields = digitsValue(fields[0]), fields[1:]
+		if n == 0 || len(fields) == 0 {
+			return fail("expected a unit after the count")
+		}
+	}
+	unit := fields[0]
+	fields = fields[1:]
+
+	c := everyDay
+	var at, on string // what the "at" and "on" clauses set, if allowed
+	switch unit {
+	case "second", "seconds", "minute", "minutes", "hour", "hours":
+		// Counts above one take plural units, and only they do.
+		if (n > 1) != strings.HasSuffix(unit, "s") {
+			return fail(fmt.Sprintf("unexpected %q", unit))
+		}
+		switch strings.TrimSuffix(unit, "s") {
+		case "second":
+			if n > 59 {
+				return fail("too many seconds")
+			}
+			if 60%n != 0 {
+				return fail(fmt.Sprintf("%d seconds don't divide a minute evenly", n))
+			}
+			c.seconds = true
+			c.second, c.minute, c.hour = cronRange(0, 59, n), cronRange(0, 59, 1), cronRange(0, 23, 1)
+		case "minute":
+			if n > 59 {
+				return fail("too many minutes")
+			}
+			if 60%n != 0 {
+				return fail(fmt.Sprintf("%d minutes don't divide an hour evenly", n))
+			}
+			c.minute, c.hour = cronRange(0, 59, n), cronRange(0, 23, 1)
+		case "hour":
+			if n > 23 {
+				return fail("too many hours")
+			}
+			if 24%n != 0 {
+				return fail(fmt.Sprintf("%d hours don't divide a day evenly", n))
+			}
+			c.hour = cronRange(0, 23, n)
+			at = "minute"
+		}
+	case "days", "weeks", "months", "years":
+		return fail(fmt.Sprintf("cron can't repeat every %d %s", n, unit))
+	case UnitDay, "weekday", "weekend", UnitWeek, UnitMonth, UnitYear:
+		if n > 1 {
+			return fail(fmt.Sprintf("unexpected %q", unit))
+		}
+		at = "time"
+		switch unit {
+		case "weekday":
+			c.dow, c.dowAny = weekdays, false
+		case "weekend":
+			c.dow, c.dowAny = weekendDays, false
+		case UnitWeek:
+			c.dow, c.dowAny = 1<<time.Sunday, false
+			on = UnitWeek
+		case UnitMonth:
+			c.dom, c.domAny = 1<<1, false
+			on = UnitMonth
+		case UnitYear:
+			c.dom, c.domAny, c.month = 1<<1, false, 1<<time.January
+			on = UnitYear
+		}
+	default:
+		days, rest, ok := readRecurrenceDays(append([]string{unit}, fields...))
+		if !ok || n > 1 {
+			return fail(fmt.Sprintf("unexpected %q", unit))
+		}
+		c.dow, c.dowAny, fields = days, false, rest
+		at = "time"
+	}
+
+	for len(fields) > 0 {
+		var ok bool
+		switch {
+		case fields[0] == "on" && on != "":
+			ok, fields = c.readRecurrenceOn(on, fields[1:])
+			on = ""
+		case fields[0] == "at" && at != "":
+			ok, fields = c.readRecurrenceAt(at, fields[1:])
+			at = ""
+		}
+		if !ok {
+			return fail(fmt.Sprintf("unexpected %q", strings.Join(fields, " ")))
+		}
+	}
+	return &c, nil
+}
+
+// looksLikeRecurrence reports whether str is a recurrence rather than a
+// date: it starts with "every" or is one of the one-word recurrences.
+func looksLikeRecurrence(str string) bool {
+	str = strings.ToLower(strings.TrimSpace(str))
+	_, alias := recurrenceAliases[str]
+	return alias || strings.HasPrefix(str, "every ")
+}
+
+// readRecurrenceDays reads days of the week separated by "and" ("monday",
+// "tuesday and thursday", "mondays"), and returns them with the fields after
+// them.
+func readRecurrenceDays(fields []string) (uint64, []string, bool) {
+	var days uint64
+	for i := 0; i < len(fields); i++ {
+		if i%2 == 1 {
+			if fields[i] != "and" {
+				return days, fields[i:], days != 0
+			}
+			continue
+		}
+		d := getDayOfWeek(fields[i])
+		if d < 0 {
+			d = getDayOfWeek(strings.TrimSuffix(fields[i], "s"))
+		}
+		if d < 0 {
+			return 0, nil, false
+		}
+		days |= 1 << d
+	}
+	return days, nil, days != 0 && len(fields)%2 == 1
+}
+
+// readRecurrenceOn reads what follows "on": days of the week for weeks,
+// days of the month ("the 1st and 15th", "day 15") for months, and a date
+// ("july 4th", "jan 1") for years.
+func (c *Cron) readRecurrenceOn(on string, fields []string) (bool, []string) {
+	switch on {
+	case UnitWeek:
+		days, rest, ok := readRecurrenceDays(fields)
+		if !ok {
+			return false, fields
+		}
+		c.dow = days
+		return true, rest
+	case UnitMonth:
+		if len(fields) > 0 && (fields[0] == "the" || fields[0] == "day") {
+			fields = fields[1:]
+		}
+		var days uint64
+		for i := 0; i < len(fields); i += 2 {
+			d, ok := readRecurrenceDay(fields[i])
+			if !ok {
+				return false, fields
+			}
+			days |= 1 << d
+			if i+1 >= len(fields) || fields[i+1] != "and" {
+				c.dom = days
+				return true, fields[i+1:]
+			}
+		}
+		return false, fields
+	default:
+		if len(fields) < 2 {
+			return false, fields
+		}
+		m, ok := getMonthByName(fields[0])
+		d, dayOK := readRecurrenceDay(fields[1])
+		if !ok || !dayOK || d > daysInMonth(2000, m) {
+			return false, fields
+		}
+		c.month, c.dom = 1<<m, 1<<d
+		return true, fields[2:]
+	}
+}
+
+// readRecurrenceDay reads a day of the month, as "15" or "15th".
+func readRecurrenceDay(w string) (int, bool) {
+	d, ok := ordinalNumber(w)
+	if !ok && isAllDigits(w) && len(w) <= 2 {
+		d, ok = digitsValue(w), true
+	}
+	return d, ok && d >= 1 && d <= 31
+}
+
+// readRecurrenceAt reads what follows "at": a minute past the hour for
+// hours ("minute 30"), and times of day separated by "and" otherwise.
+func (c *Cron) readRecurrenceAt(at string, fields []string) (bool, []string) {
+	if at == "minute" {
+		if len(fields) < 2 || fields[0] != "minute" || !isAllDigits(fields[1]) || len(fields[1]) > 2 {
+			return false, fields
+		}
+		m := digitsValue(fields[1])
+		if m > 59 {
+			return false, fields
+		}
+		c.minute = 1 << m
+		return true, fields[2:]
+	}
+
+	var hours uint64
+	minute := -1
+	for {
+		h, m, n, ok := readRecurrenceClock(fields)
+		if !ok || (minute >= 0 && m != minute) {
+			return false, fields
+		}
+		hours |= 1 << h
+		minute = m
+		fields = fields[n:]
+		if len(fields) == 0 || fields[0] != "and" {
+			break
+		}
+		fields = fields[1:]
+	}
+	c.hour, c.minute = hours, 1<<minute
+	return true, fields
+}
+
+// readRecurrenceClock reads a time of day from the start of fields: "9",
+// "9am", "9 am", "9:30pm", "17:30", "noon" or "midnight". It returns the
+// hour, the minute and the number of fields read.
+func readRecurrenceClock(fields []string) (hour, minute, n int, ok bool) {
+	if len(fields) == 0 {
+		return 0, 0, 0, false
+	}
+	switch fields[0] {
+	case "noon":
+		return 12, 0, 1, true
+	case "midnight":
+		return 0, 0, 1, true
+	}
+	s, n := fields[0], 1
+	ampm := ""
+	if len(fields) > 1 && (fields[1] == "am" || fields[1] == "pm") {
+		ampm, n = fields[1], 2
+	} else if strings.HasSuffix(s, "am") || strings.HasSuffix(s, "pm") {
+		s, ampm = s[:len(s)-2], s[len(s)-2:]
+	}
+	hs, ms, hasMinute := strings.Cut(s, ":")
+	if hs == "" || len(hs) > 2 || !isAllDigits(hs) || (hasMinute && (len(ms) != 2 || !isAllDigits(ms))) {
+		return 0, 0, 0, false
+	}
+	hour = digitsValue(hs)
+	if hasMinute {
+		minute = digitsValue(ms)
+	}
+	if ampm != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, false
+		}
+		hour = applyAMPM(hour, ampm)
+	}
+	if hour > 23 || minute > 59 || (!hasMinute && ampm == "") {
+		return 0, 0, 0, false
+	}
+	return hour, minute, n, true
+}
+
+// Recurrence describes the schedule in the English of ParseRecurrence, such
+// as "every weekday at 9am" for "0 9 * * 1-5". Schedules that phrase can't
+// express, such as "5 4 * 6 *", give an error matching ErrInvalidSchedule.
+func (c *Cron) Recurrence() (string, error) {
+	fail := func() (string, error) {
+		return "", fmt.Errorf("%w %q: no English equivalent", ErrInvalidSchedule, c.String())
+	}
+	allMonths, allDays := c.month == everyDay.month, c.domAny && c.dowAny
+	allHours, allMinutes := c.hour == cronRange(0, 23, 1), c.minute == cronRange(0, 59, 1)
+
+	if c.seconds && c.second != 1 {
+		step := cronStep(c.second, 0, 59)
+		if !allMinutes || !allHours || !allMonths || !allDays || (step == 0 && c.second != cronRange(0, 59, 1)) || (step > 0 && 60%step != 0) {
+			return fail()
+		}
+		if step == 0 {
+			return "every second", nil
+		}
+		return fmt.Sprintf("every %d seconds", step), nil
+	}
+
+	if allMonths && allDays {
+		switch {
+		case allHours && allMinutes:
+			return "every minute", nil
+		case allHours && cronStep(c.minute, 0, 59) > 1 && 60%cronStep(c.minute, 0, 59) == 0:
+			return fmt.Sprintf("every %d minutes", cronStep(c.minute, 0, 59)), nil
+		case c.minute == 1 && allHours:
+			return "every hour", nil
+		case c.minute == 1 && cronStep(c.hour, 0, 23) > 1 && 24%cronStep(c.hour, 0, 23) == 0:
+			return fmt.Sprintf("every %d hours", cronStep(c.hour, 0, 23)), nil
+		case bits.OnesCount64(c.minute) == 1 && allHours:
+			return fmt.Sprintf("every hour at minute %d", bits.TrailingZeros64(c.minute)), nil
+		}
+	}
+	if bits.OnesCount64(c.minute) != 1 || c.hour == 0 {
+		return fail()
+	}
+	at := " at " + recurrenceTimes(c.hour, bits.TrailingZeros64(c.minute))
+
+	switch {
+	case allMonths && allDays:
+		return "every day" + at, nil
+	case allMonths && c.domAny:
+		switch c.dow {
+		case weekdays:
+			return "every weekday" + at, nil
+		case weekendDays:
+			return "every weekend" + at, nil
+		}
+		return "every " + recurrenceList(c.dow, 0, 6, func(d int) string {
+			return strings.ToLower(time.Weekday(d).String())
+		}) + at, nil
+	case allMonths && c.dowAny:
+		return "every month on the " + recurrenceList(c.dom, 1, 31, func(d int) string {
+			return strconv.Itoa(d) + ordinalSuffix(d)
+		}) + at, nil
+	case c.dowAny && bits.OnesCount64(c.month) == 1 && bits.OnesCount64(c.dom) == 1:
+		m, d := bits.TrailingZeros64(c.month), bits.TrailingZeros64(c.dom)
+		return fmt.Sprintf("every year on %s %d%s", strings.ToLower(time.Month(m).String()), d, ordinalSuffix(d)) + at, nil
+	}
+	return fail()
+}
+
+// recurrenceTimes writes the times of day at the given hours and minute:
+// "9am", "9:30am and 5:30pm", "noon", "midnight".
+func recurrenceTimes(hours uint64, minute int) string {
+	return recurrenceList(hours, 0, 23, func(h int) string {
+		switch {
+		case minute == 0 && h == 0:
+			return "midnight"
+		case minute == 0 && h == 12:
+			return "noon"
+		}
+		ampm := "am"
+		if h >= 12 {
+			ampm = "pm"
+		}
+		if h = h % 12; h == 0 {
+			h = 12
+		}
+		if minute == 0 {
+			return strconv.Itoa(h) + ampm
+		}
+		return fmt.Sprintf("%d:%02d%s", h, minute, ampm)
+	})
+}
+
+// recurrenceList writes the values of set from lo to hi with name,
+// separated by "and".
+func recurrenceList(set uint64, lo, hi int, name func(int) string) string {
+	var parts []string
+	for v := lo; v <= hi; v++ {
+		if set&(1<<v) != 0 {
+			parts = append(parts, name(v))
+		}
+	}
+	return strings.Join(parts, " and ")
+}
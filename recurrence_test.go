@@ -0,0 +1,104 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		phrase, cron, text string
+	}{
+		{"every weekday at 9am", "0 9 * * 1-5", "every weekday at 9am"},
+		{"Every 15 minutes", "*/15 * * * *", "every 15 minutes"},
+		{"every minute", "* * * * *", "every minute"},
+		{"every 10 seconds", "*/10 * * * * *", "every 10 seconds"},
+		{"every hour", "0 * * * *", "every hour"},
+		{"every 2 hours", "0 */2 * * *", "every 2 hours"},
+		{"every hour at minute 30", "30 * * * *", "every hour at minute 30"},
+		{"every day", "0 0 * * *", "every day at midnight"},
+		{"every day at 9:30 and 17:30", "30 9,17 * * *", "every day at 9:30am and 5:30pm"},
+		{"every weekend at 10:30 am", "30 10 * * 0,6", "every weekend at 10:30am"},
+		{"every monday and friday at noon", "0 12 * * 1,5", "every monday and friday at noon"},
+		{"every tuesdays, thursdays at 6pm", "", ""},
+		{"every week on friday", "0 0 * * 5", "every friday at midnight"},
+		{"every month on the 1st and 15th at 8am", "0 8 1,15 * *", "every month on the 1st and 15th at 8am"},
+		{"every year on july 4th", "0 0 4 7 *", "every year on july 4th at midnight"},
+		{"weekly", "0 0 * * 0", "every sunday at midnight"},
+		{"monthly", "0 0 1 * *", "every month on the 1st at midnight"},
+	}
+	for _, tt := range tests {
+		c, err := ParseRecurrence(tt.phrase)
+		if tt.cron == "" {
+			if err == nil {
+				t.Errorf("ParseRecurrence(%q) = %s, want an error", tt.phrase, c)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseRecurrence(%q): %v", tt.phrase, err)
+			continue
+		}
+		if got := c.String(); got != tt.cron {
+			t.Errorf("ParseRecurrence(%q) = %s, want %s", tt.phrase, got, tt.cron)
+		}
+		text, err := c.Recurrence()
+		if err != nil || text != tt.text {
+			t.Errorf("ParseRecurrence(%q).Recurrence() = %q, %v, want %q", tt.phrase, text, err, tt.text)
+		}
+		// The description reads back as the same schedule.
+		if again, err := ParseRecurrence(text); err != nil || again.String() != tt.cron {
+			t.Errorf("ParseRecurrence(%q) = %v, %v, want %s", text, again, err, tt.cron)
+		}
+	}
+
+	for _, phrase := range []string{"", "every", "every 3 days", "every 2 weeks", "every 1 minutes",
+		"every 5 minute", "every 90 minutes", "every day at 9am and 5:30pm", "every day at 25:00",
+		"every day at 13pm", "every month on the 32nd", "every year on february 30th", "every monday and",
+		"every hour at 9am", "every day on monday", "each day", "every 7 seconds", "every 7 minutes",
+		"every 45 minutes", "every 5 hours", "every 7 hours"} {
+		if _, err := ParseRecurrence(phrase); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("ParseRecurrence(%q) error = %v, want ErrInvalidSchedule", phrase, err)
+		}
+	}
+}
+
+func TestCronRecurrence(t *testing.T) {
+	for expr, want := range map[string]string{
+		"0 9 * * 1-5":  "every weekday at 9am",
+		"0 0,12 * * *": "every 12 hours",
+		"0 6,18 * * *": "every day at 6am and 6pm",
+		"0 0 1 1 *":    "every year on january 1st at midnight",
+		"*/5 * * * *":  "every 5 minutes",
+		"0 */7 * * *":  "every day at midnight and 7am and 2pm and 9pm",
+	} {
+		c, err := ParseCron(expr)
+		if err != nil {
+			t.Fatalf("ParseCron(%q): %v", expr, err)
+		}
+		if got, err := c.Recurrence(); err != nil || got != want {
+			t.Errorf("%q: Recurrence() = %q, %v, want %q", expr, got, err, want)
+		}
+	}
+
+	for _, expr := range []string{"5 4 * 6 *", "0 9 1 * mon", "*/10 9 * * *", "0 0 1,15 1 *", "*/7 * * * *", "*/7 * * * * *"} {
+		c, _ := ParseCron(expr)
+		if got, err := c.Recurrence(); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("%q: Recurrence() = %q, %v, want ErrInvalidSchedule", expr, got, err)
+		}
+	}
+}
+
+func TestParseScheduleRecurrence(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC) // a Friday
+	got, err := ParseSchedule("every weekday at 9am", Rel(base))
+	if want := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC); err != nil || !got.Equal(want) {
+		t.Errorf("ParseSchedule(\"every weekday at 9am\") = %v, %v, want %v", got, err, want)
+	}
+	if _, err := ParseSchedule("every 3 days", Rel(base)); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("ParseSchedule(\"every 3 days\") error = %v, want ErrInvalidSchedule", err)
+	}
+}
@@ -0,0 +1,146 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// parseRFC2822 parses the dates of email and HTTP headers, RFC 2822's
+// "Tue, 15 Jan 2023 10:30:45 +0100" and RFC 1123's "Sun, 06 Nov 1994
+// 08:49:37 GMT". The day of the week is optional but, when given, must be
+// the one of the date. The seconds are optional, the year has two or four
+// digits and the zone is a numeric offset, "UT", "GMT" or a zone name,
+// optionally followed by a comment in parentheses: "-0500 (EST)". The
+// comment may hold one level of nested comments, "(EST (US))", but no
+// more. Most of these dates are also read by the PHP formats; this covers
+// the forms they reject.
+//
+// matched reports whether str has the shape of such a date; err is set when
+// it does but names a day that doesn't exist, such as February 30th.
+func parseRFC2822(str string) (t time.Time, matched bool, err error) {
+	if strings.HasSuffix(str, ")") {
+		open := rfc2822CommentStart(str)
+		if open < 0 {
+			return time.Time{}, false, nil
+		}
+		str = str[:open]
+	}
+	fields := appendFields(make([]string, 0, fieldBufLen), str)
+	weekday := -1
+	if len(fields) > 0 && strings.HasSuffix(fields[0], ",") {
+		if weekday = getDayOfWeek(strings.TrimSuffix(fields[0], ",")); weekday < 0 {
+			return time.Time{}, false, nil
+		}
+		fields = fields[1:]
+	}
+	if len(fields) != 5 {
+		return time.Time{}, false, nil
+	}
+
+	month, ok := getMonthByName(fields[1])
+	if !ok || len(fields[0]) > 2 || !isAllDigits(fields[0]) || !isAllDigits(fields[2]) {
+		return time.Time{}, false, nil
+	}
+	day, year := digitsValue(fields[0]), digitsValue(fields[2])
+	switch len(fields[2]) {
+	case 2:
+		year = parseTwoDigitYear(year)
+	case 4:
+	default:
+		return time.Time{}, false, nil
+	}
+	hour, minute, second, ok := readRFC2822Clock(fields[3])
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	zone, ok := readRFC2822Zone(fields[4])
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	if !IsValidDate(year, int(month), day) {
+		return time.Time{}, true, NewInvalidDateError(year, int(month), day)
+	}
+	t = time.Date(year, month, day, hour, minute, second, 0, zone)
+	if weekday >= 0 && int(t.Weekday()) != weekday {
+		return time.Time{}, false, nil
+	}
+	return t, true, nil
+}
+
+// rfc2822CommentStart returns the offset of the "(" opening the comment
+// that ends str, or -1 when the parentheses don't balance, nest more than
+// one level deep or appear before the comment.
+func rfc2822CommentStart(str string) int {
+	depth := 0
+	for i := len(str) - 1; i >= 0; i-- {
+		switch str[i] {
+		case ')':
+			if depth++; depth > 2 {
+				return -1
+			}
+		case '(':
+			if depth--; depth == 0 {
+				if strings.ContainsAny(str[:i], "()") {
+					return -1
+				}
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// readRFC2822Clock reads "hh:mm" or "hh:mm:ss".
+func readRFC2822Clock(s string) (hour, minute, second int, ok bool) {
+	var i int
+	if hour, i, ok = readDigitField(s, 0, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return 0, 0, 0, false
+	}
+	if minute, i, ok = readDigitField(s, i+1, 2, 2); !ok {
+		return 0, 0, 0, false
+	}
+	if i < len(s) {
+		if s[i] != ':' {
+			return 0, 0, 0, false
+		}
+		if second, i, ok = readDigitField(s, i+1, 2, 2); !ok || i != len(s) {
+			return 0, 0, 0, false
+		}
+	}
+	return hour, minute, second, IsValidTime(hour, minute, second)
+}
+
+// readRFC2822Zone reads the zone of an RFC 2822 date: "+hhmm", "-hhmm",
+// "UT", "GMT" or a zone name such as "EST".
+func readRFC2822Zone(s string) (*time.Location, bool) {
+	switch {
+	case s == "ut" || s == "gmt" || s == "z":
+		return time.UTC, true
+	case s[0] == '+' || s[0] == '-':
+		if len(s) != 5 || !isAllDigits(s[1:]) {
+			return nil, false
+		}
+		tz, n, ok := parseNumericTimezoneOffset(s)
+		return tz, ok && n == len(s)
+	}
+	return tryParseTimezone(s)
+}
+
+func parseRFC2822Into(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, matched, err := parseRFC2822(str)
+	if !matched {
+		return false
+	}
+	if err != nil {
+		// The date is the error, not text a later stage would leave unread.
+		pd.cause = err
+		return true
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(t.Hour(), t.Minute(), t.Second())
+	setTZFromLocation(pd, t.Location(), t)
+	pd.setMaterialized(t)
+	return true
+}
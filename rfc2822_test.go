@@ -0,0 +1,52 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRFC2822Dates(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	minus5 := time.FixedZone("", -5*3600)
+	tests := map[string]time.Time{
+		"Sun, 15 Jan 2023 10:30:45 +0100":        time.Date(2023, 1, 15, 9, 30, 45, 0, time.UTC),
+		"Sun, 06 Nov 1994 08:49:37 GMT":          time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC),
+		"Sun, 06 Nov 1994 08:49:37 UT":           time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC),
+		"Sun, 06 Nov 94 08:49 UT":                time.Date(1994, 11, 6, 8, 49, 0, 0, time.UTC),
+		"Tue, 17 Jan 2023 10:30:45 -0500 (EST)":  time.Date(2023, 1, 17, 10, 30, 45, 0, minus5),
+		"17 Jan 2023 10:30:45 EST (Eastern Std)": time.Date(2023, 1, 17, 10, 30, 45, 0, minus5),
+		"Sun, 06 Nov 1994 08:49:37 GMT (a (b))":  time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"Sun, 06 Nov 1994 08:49:37 GMT (a (b (c)))",
+		"Sun, 06 Nov 1994 08:49:37 GMT (a) (b)",
+		"Sun, 31 Nov 1994 08:49:37 UT",
+		"Sun, 06 Nov 1994 24:49:37 UT",
+		"Sun, 06 Nov 1994 08:49:37 UT (comment",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+
+	// A date of the right shape that doesn't exist says so.
+	for _, input := range []string{"30 Feb 2023 10:30:45 +0100 (CET)", "31 Nov 1994 08:49:37 UT (comment)"} {
+		if got, err := StrToTime(input, Rel(base)); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("StrToTime(%q) = %v, %v, want ErrInvalidDate", input, got, err)
+		}
+	}
+}
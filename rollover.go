@@ -0,0 +1,100 @@
+package strtotime
+
+import "time"
+
+// rolloverFields holds the components read by parseRollover, before any
+// normalization.
+type rolloverFields struct {
+	year, month, day     int
+	hour, minute, second int
+	hasDate, hasTime     bool
+}
+
+// parseRollover reads the numeric "YYYY-MM-DD", "YYYY/MM/DD", "hh:mm[:ss]"
+// and "YYYY-MM-DD[ T]hh:mm[:ss]" shapes without checking the range of the
+// month, day, hour, minute or second. Every field but the year has one or two
+// digits.
+func parseRollover(str string) (rolloverFields, bool) {
+	var f rolloverFields
+	i := 0
+	if len(str) > 4 && (str[4] == '-' || str[4] == '/') {
+		sep := str[4]
+		var ok bool
+		if f.year, i, ok = readDigitField(str, 0, 4, 4); !ok {
+			return f, false
+		}
+		if f.month, i, ok = readDigitField(str, i+1, 1, 2); !ok || i >= len(str) || str[i] != sep {
+			return f, false
+		}
+		if f.day, i, ok = readDigitField(str, i+1, 1, 2); !ok {
+			return f, false
+		}
+		f.hasDate = true
+		if i == len(str) {
+			return f, true
+		}
+		if str[i] != ' ' && str[i] != 't' && str[i] != 'T' {
+			return f, false
+		}
+		i++
+	}
+
+	var ok bool
+	if f.hour, i, ok = readDigitField(str, i, 1, 2); !ok || i >= len(str) || str[i] != ':' {
+		return f, false
+	}
+	if f.minute, i, ok = readDigitField(str, i+1, 1, 2); !ok {
+		return f, false
+	}
+	if i < len(str) && str[i] == ':' {
+		if f.second, i, ok = readDigitField(str, i+1, 1, 2); !ok {
+			return f, false
+		}
+	}
+	f.hasTime = true
+	return f, i == len(str)
+}
+
+// readDigitField reads a run of minLen to maxLen digits of s starting at
+// i, returning its value and the offset just past it.
+func readDigitField(s string, i, minLen, maxLen int) (int, int, bool) {
+	j := i
+	for j < len(s) && j-i < maxLen && s[j] >= '0' && s[j] <= '9' {
+		j++
+	}
+	if j-i < minLen || (j < len(s) && s[j] >= '0' && s[j] <= '9') {
+		return 0, i, false
+	}
+	return digitsValue(s[i:j]), j, true
+}
+
+// parseRolloverInto parses the inputs accepted by parseRollover, rolling
+// out-of-range components over into the next unit: "2023-01-32" is February
+// 1st and "25:70:00" is 02:10:00 the next day. It is tried under the Rollover
+// option once the regular pipeline has rejected the input. The components
+// are reported as written, with PHP's "invalid" warning.
+func parseRolloverInto(str string, now time.Time, loc *time.Location, pd *ParsedDate) bool {
+	f, ok := parseRollover(str)
+	if !ok {
+		return false
+	}
+	pd.reset()
+
+	y, m, d := now.In(loc).Date()
+	if f.hasDate {
+		y, m, d = f.year, time.Month(f.month), f.day
+		pd.SetDate(f.year, f.month, f.day)
+		if !IsValidDate(f.year, f.month, f.day) {
+			pd.AddWarning(len(str)+1, "The parsed date was invalid")
+		}
+	}
+	pd.SetTime(f.hour, f.minute, f.second)
+	if f.hasTime {
+		pd.SetFraction(0)
+		if pd.WarningCount == 0 && !IsValidTime(f.hour, f.minute, f.second) {
+			pd.AddWarning(len(str)+1, "The parsed time was invalid")
+		}
+	}
+	pd.setMaterialized(time.Date(y, m, d, f.hour, f.minute, f.second, 0, loc))
+	return true
+}
@@ -0,0 +1,57 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRollover(t *testing.T) {
+	base := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	opts := []Option{Rel(base), InTZ(time.UTC), Rollover()}
+
+	tests := map[string]time.Time{
+		"2023-01-32":          time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		"2023-13-01":          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"2023/02/00":          time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+		"25:70:00":            time.Date(2024, 3, 11, 2, 10, 0, 0, time.UTC),
+		"10:61":               time.Date(2024, 3, 10, 11, 1, 0, 0, time.UTC),
+		"2023-12-31 23:59:60": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"2023-01-15t24:30":    time.Date(2023, 1, 16, 0, 30, 0, 0, time.UTC),
+		"2023-02-30":          time.Date(2023, 3, 2, 0, 0, 0, 0, time.UTC),
+		"2023-01-15 10:00":    time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, opts...)
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"2023-01-32", "2023-13-01", "25:70:00", "10:61"} {
+		if _, err := StrToTime(input, Rel(base), InTZ(time.UTC)); err == nil {
+			t.Errorf("StrToTime(%q) should fail without Rollover", input)
+		}
+	}
+	for _, input := range []string{"2023-01-123", "2023-01/15", "123:00", "10:", "2023-01-15x10:00"} {
+		if _, err := StrToTime(input, opts...); err == nil {
+			t.Errorf("StrToTime(%q) should fail even with Rollover", input)
+		}
+	}
+}
+
+func TestParseRollover(t *testing.T) {
+	f, ok := parseRollover("2023-01-32 25:70:61")
+	want := rolloverFields{2023, 1, 32, 25, 70, 61, true, true}
+	if !ok || f != want {
+		t.Errorf("parseRollover = %+v, %v, want %+v", f, ok, want)
+	}
+	f, ok = parseRollover("7:5")
+	want = rolloverFields{hour: 7, minute: 5, hasTime: true}
+	if !ok || f != want {
+		t.Errorf("parseRollover = %+v, %v, want %+v", f, ok, want)
+	}
+}
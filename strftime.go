@@ -0,0 +1,129 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// strftimeComposites are the directives standing for several others.
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+// strftimeDirectives are the directives reading a single field.
+var strftimeDirectives = map[byte][]layoutElem{
+	'a': {{kind: layoutWeekday}},
+	'A': {{kind: layoutWeekday}},
+	'b': {{kind: layoutMonthName}},
+	'B': {{kind: layoutMonthName}},
+	'h': {{kind: layoutMonthName}},
+	'd': {{kind: layoutDay, min: 2, max: 2}},
+	'e': {{kind: layoutSpace}, {kind: layoutDay, min: 1, max: 2}},
+	'f': {{kind: layoutFraction, min: 6, max: 9}},
+	'N': {{kind: layoutFraction, min: 9, max: 9}},
+	'L': {{kind: layoutFraction, min: 3, max: 3}},
+	'H': {{kind: layoutHour, min: 2, max: 2}},
+	'k': {{kind: layoutSpace}, {kind: layoutHour, min: 1, max: 2}},
+	'I': {{kind: layoutHour12, min: 2, max: 2}},
+	'l': {{kind: layoutSpace}, {kind: layoutHour12, min: 1, max: 2}},
+	'j': {{kind: layoutYearDay, min: 3, max: 3}},
+	'm': {{kind: layoutMonth, min: 2, max: 2}},
+	'M': {{kind: layoutMinute, min: 2, max: 2}},
+	'S': {{kind: layoutSecond, min: 2, max: 2}},
+	'p': {{kind: layoutMeridian}},
+	'P': {{kind: layoutMeridian}},
+	's': {{kind: layoutEpoch}},
+	'u': {{kind: layoutNumber, min: 1, max: 1}},
+	'w': {{kind: layoutNumber, min: 1, max: 1}},
+	'y': {{kind: layoutYear2, min: 2, max: 2}},
+	'Y': {{kind: layoutYear, min: 4, max: 9}},
+	'z': {{kind: layoutOffset}},
+	'Z': {{kind: layoutZone}},
+	'n': {{kind: layoutSpace}},
+	't': {{kind: layoutSpace}},
+	'%': {{kind: layoutLiteral, text: "%"}},
+}
+
+// ParseStrftime parses value following format, written with the directives
+// of C's strftime and strptime as Python and Ruby use them, such as
+// "%Y-%m-%d %H:%M:%S %z".
+//
+// The directives %a, %A, %b, %B, %h, %c, %d, %D, %e, %f, %F, %H, %I, %j,
+// %k, %l, %L, %m, %M, %n, %N, %p, %P, %r, %R, %s, %S, %t, %T, %u, %w, %x,
+// %X, %y, %Y, %z, %Z and %% are supported, in their C locale forms; the
+// flags "-", "_", "0", "^" and "#" of GNU and Python are accepted and
+// ignored. Numeric fields read up to their full width, fewer digits being
+// accepted when something else follows. %f (microseconds in Python), %L
+// (milliseconds in Ruby) and %N read a fraction of a second, %y a year
+// between 1970 and 2069, and %z a "Z" or a UTC offset with or without a
+// colon. Whitespace in format matches any run of whitespace in value,
+// including none.
+//
+// Fields missing from the format default to January 1st, 1970 at midnight,
+// in the location set with InTZ or the local one when the value has no zone.
+func ParseStrftime(format, value string, opts ...Option) (time.Time, error) {
+	layout, err := strftimeLayout(format, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	_, loc := resolveOptions(withDefaults(opts))
+	return parseLayout(layout, value, loc)
+}
+
+// strftimeLayout compiles format, appending its elements to layout.
+func strftimeLayout(format string, layout []layoutElem) ([]layoutElem, error) {
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			layout = append(layout, layoutElem{kind: layoutLiteral, text: lit.String()})
+			lit.Reset()
+		}
+	}
+
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if isSpaceByte(c) {
+			flush()
+			for i+1 < len(format) && isSpaceByte(format[i+1]) {
+				i++
+			}
+			layout = append(layout, layoutElem{kind: layoutSpace})
+			continue
+		}
+		if c != '%' {
+			lit.WriteByte(c)
+			continue
+		}
+
+		i++
+		for i < len(format) && strings.IndexByte("-_0^#", format[i]) >= 0 {
+			i++
+		}
+		if i == len(format) {
+			return nil, fmt.Errorf("%w %q: trailing %%", ErrInvalidLayout, format)
+		}
+		flush()
+		d := format[i]
+		if sub, ok := strftimeComposites[d]; ok {
+			layout, _ = strftimeLayout(sub, layout)
+			continue
+		}
+		elems, ok := strftimeDirectives[d]
+		if !ok {
+			return nil, fmt.Errorf("%w %q: unsupported directive %%%c", ErrInvalidLayout, format, d)
+		}
+		layout = append(layout, elems...)
+	}
+	flush()
+	return layout, nil
+}
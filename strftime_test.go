@@ -0,0 +1,67 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseStrftime(t *testing.T) {
+	minus7 := time.FixedZone("", -7*3600)
+	plus530 := time.FixedZone("", 5*3600+30*60)
+	tests := []struct {
+		format, value string
+		want          time.Time
+	}{
+		{"%Y-%m-%d %H:%M:%S %z", "2001-07-04 12:08:56 -0700", time.Date(2001, 7, 4, 12, 8, 56, 0, minus7)},
+		{"%Y-%m-%dT%H:%M:%S.%f%z", "2001-07-04T12:08:56.235000+05:30", time.Date(2001, 7, 4, 12, 8, 56, 235000000, plus530)},
+		{"%Y-%m-%dT%H:%M:%S%z", "2001-07-04T12:08:56Z", time.Date(2001, 7, 4, 12, 8, 56, 0, time.UTC)},
+		{"%a, %d %b %Y %H:%M:%S %Z", "Wed, 04 Jul 2001 12:08:56 PDT", time.Date(2001, 7, 4, 12, 8, 56, 0, minus7)},
+		{"%A %B %e %Y", "Wednesday July  4 2001", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"%c", "Wed Jul  4 12:08:56 2001", time.Date(2001, 7, 4, 12, 8, 56, 0, time.UTC)},
+		{"%D %r", "07/04/01 12:08:56 AM", time.Date(2001, 7, 4, 0, 8, 56, 0, time.UTC)},
+		{"%F %T", "2001-07-04 12:08:56", time.Date(2001, 7, 4, 12, 8, 56, 0, time.UTC)},
+		{"%Y%m%d%H%M%S", "20010704120856", time.Date(2001, 7, 4, 12, 8, 56, 0, time.UTC)},
+		{"%d/%m/%Y", "4/7/2001", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"%-d/%-m/%Y %-I:%M %p", "4/7/2001 3:05 pm", time.Date(2001, 7, 4, 15, 5, 0, 0, time.UTC)},
+		{"%Y-%j", "2024-060", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"%s", "994248536", time.Date(2001, 7, 4, 12, 8, 56, 0, time.UTC)},
+		{"%H:%M:%S.%L", "12:08:56.235", time.Date(1970, 1, 1, 12, 8, 56, 235000000, time.UTC)},
+		{"%Y-%m-%d %H:%M:%S.%N", "2001-07-04 12:08:56.123456789", time.Date(2001, 7, 4, 12, 8, 56, 123456789, time.UTC)},
+		{"100%% on %F", "100% on 2001-07-04", time.Date(2001, 7, 4, 0, 0, 0, 0, time.UTC)},
+		{"%Y-%m-%d  %H:%M", "2001-07-04T12:08", time.Time{}},
+	}
+	for _, tt := range tests {
+		got, err := ParseStrftime(tt.format, tt.value, InTZ(time.UTC))
+		if tt.want.IsZero() {
+			if err == nil {
+				t.Errorf("ParseStrftime(%q, %q) = %v, want an error", tt.format, tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseStrftime(%q, %q) error: %v", tt.format, tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) || got.Nanosecond() != tt.want.Nanosecond() {
+			t.Errorf("ParseStrftime(%q, %q) = %v, want %v", tt.format, tt.value, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		format, value string
+		want          error
+	}{
+		{"%Y-%m-%d", "2023-02-30", ErrInvalidDate},
+		{"%Y-%m-%d", "2023-02-03 extra", ErrInvalidDateFormat},
+		{"%H:%M", "12h05", ErrInvalidDateFormat},
+		{"%G-W%V", "2001-W27", ErrInvalidLayout},
+		{"%Y-%m-%", "2001-07-", ErrInvalidLayout},
+	} {
+		if _, err := ParseStrftime(tt.format, tt.value); !errors.Is(err, tt.want) {
+			t.Errorf("ParseStrftime(%q, %q) error = %v, want %v", tt.format, tt.value, err, tt.want)
+		}
+	}
+}
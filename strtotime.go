@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // resolveOptions processes StrToTime options and returns the base time and location.
@@ -40,17 +41,20 @@ func resolveOptions(opts []Option) (time.Time, *time.Location) {
 }
 
 // tryParseUnixTimestamp handles "@timestamp" and "@timestamp.fraction [TZ]" format.
+// The zone, a name or a numeric offset, changes only the zone the instant is
+// shown in.
 func tryParseUnixTimestamp(str string, loc *time.Location) (time.Time, bool) {
 	if len(str) == 0 || str[0] != '@' {
 		return time.Time{}, false
 	}
-	unixTimeStr := str[1:]
-	tzParts := strings.SplitN(unixTimeStr, " ", 2)
-	timestamp := tzParts[0]
+	timestamp, zone, _ := strings.Cut(str[1:], " ")
 
 	applyTZ := func(result time.Time) time.Time {
-		if len(tzParts) > 1 && tzParts[1] != "" {
-			if tzLoc, found := tryParseTimezone(tzParts[1]); found {
+		if zone != "" {
+			if tzLoc, n, ok := parseNumericTimezoneOffset(zone); ok && n == len(zone) {
+				return result.In(tzLoc)
+			}
+			if tzLoc, found := tryParseTimezone(zone); found {
 				return result.In(tzLoc)
 			}
 		}
@@ -137,7 +141,7 @@ func tryWeekdayPrefixReparse(str string, now time.Time, loc *time.Location, opts
 	// doesn't leak the caller's local timezone into the reparse.
 	reparseOpts := append([]Option(nil), opts...)
 	reparseOpts = append(reparseOpts, InTZ(loc))
-	t, err := StrToTime(rest, reparseOpts...)
+	t, err := parseToTime(rest, reparseOpts, newParsedDate())
 	if err != nil {
 		return time.Time{}, false
 	}
@@ -154,6 +158,40 @@ func tryWeekdayPrefixReparse(str string, now time.Time, loc *time.Location, opts
 	return t, true
 }
 
+// parseLeadingWords parses str without the unknown words it ends with, for
+// the Lenient option, trying the longest start first: of "2023-01-15 bogus
+// text", "2023-01-15 bogus" and then "2023-01-15". Words with digits or that
+// some expression uses end the search, so that an error such as the one of
+// "+999999 business days" is not hidden. pd is only written when a start of
+// str parses.
+func parseLeadingWords(str string, opts []Option, pd *ParsedDate) (time.Time, bool) {
+	end := len(strings.TrimRightFunc(str, unicode.IsSpace))
+	for {
+		i := strings.LastIndexFunc(str[:end], unicode.IsSpace) + 1
+		if i == 0 || !isUnknownWord(strings.ToLower(str[i:end])) {
+			return time.Time{}, false
+		}
+		end = len(strings.TrimRightFunc(str[:i], unicode.IsSpace))
+		sub := newParsedDate()
+		if t, err := parseToTime(str[:end], opts, sub); err == nil {
+			*pd = *sub
+			return t, true
+		}
+	}
+}
+
+// isUnknownWord reports whether the lowercase word w is made of letters
+// only and is no keyword, month, weekday, unit, ordinal, zone abbreviation or
+// part of a holiday name.
+func isUnknownWord(w string) bool {
+	for i := 0; i < len(w); i++ {
+		if !isLetterByte(w[i]) {
+			return false
+		}
+	}
+	return !isKnownWord(w) && !isHolidayWord(w)
+}
+
 // restLooksLikeBareTime reports whether s is a time-of-day expression that
 // lacks any date component (no dash/slash date markers, no 4-digit year).
 func restLooksLikeBareTime(s string) bool {
@@ -181,52 +219,200 @@ func restLooksLikeBareTime(s string) bool {
 
 // StrToTime will convert the provided string into a time similarly to how PHP strtotime() works.
 func StrToTime(str string, opts ...Option) (time.Time, error) {
+	return strToTime(str, withDefaults(opts), newParsedDate())
+}
+
+// strToTime is the body of StrToTime, parsing into the caller-provided pd
+// and reporting the parse to the OnParse hooks in opts.
+func strToTime(str string, opts []Option, pd *ParsedDate) (time.Time, error) {
+	hooks := parseHooks(opts)
+	if hooks == nil {
+		return checkedParse(str, opts, pd)
+	}
+	start := time.Now()
+	t, err := checkedParse(str, opts, pd)
+	ev := ParseEvent{Input: str, Duration: time.Since(start), Err: err}
+	if err == nil {
+		ev.Format = pd.format
+	}
+	for _, fn := range hooks {
+		fn(ev)
+	}
+	return t, err
+}
+
+// checkedParse parses str into pd, translating its words under the
+// WithLocale option and reading its slash dates day first under the DayFirst
+// option, and checks the result against the TimeT32 option.
+func checkedParse(str string, opts []Option, pd *ParsedDate) (time.Time, error) {
+	cfg := resolveSettings(opts)
+	orig := str
+	str = translateLocale(str, opts)
+	if cfg.dayFirst {
+		str = swapDayFirst(str)
+	}
+	t, err := parseToTime(str, opts, pd)
+	if err != nil && cfg.skipWords && !cfg.strict {
+		if lt, ok := parseLeadingWords(str, opts, pd); ok {
+			t, err = lt, nil
+		}
+	}
+	if err != nil {
+		return t, localeError(orig, opts, err)
+	}
+	if cfg.timeT32 {
+		return limitTimeT32(t, cfg.timeT32Clamp)
+	}
+	return t, nil
+}
+
+// parseToTime parses str into pd and returns the resulting time. It is
+// strToTime without the checks on the final result, for the stages that
+// reparse part of the input.
+func parseToTime(str string, opts []Option, pd *ParsedDate) (time.Time, error) {
 	now, loc := resolveOptions(opts)
 
-	str = strings.ToLower(strings.TrimSpace(str))
+	str = collapseSpaces(normalizeInput(str))
 	if str == "" {
 		return time.Time{}, ErrEmptyTimeString
 	}
+	if implausibleInput(str) {
+		return time.Time{}, newParseError(str, "", 0, nil)
+	}
+	cfg := resolveSettings(opts)
+	if i := leapSecondIndex(str); i >= 0 && cfg.leapSeconds != LeapSecondReject {
+		return parseLeapSecond(str, i, cfg.leapSeconds, opts, pd)
+	}
+	if cfg.eras {
+		if head, year, bce, ok := splitEra(str); ok {
+			return parseEra(head, year, bce, opts, pd)
+		}
+	}
 
-	pd := newParsedDate()
-	if !dispatchStrToTime(str, now, loc, opts, pd) {
-		return time.Time{}, fmt.Errorf("unable to parse time string: %s", str)
+	ok := dispatchStrToTime(str, now, loc, opts, pd)
+	if !ok || pd.ErrorCount > 0 {
+		// Retry with commas read as spaces, then without filler words
+		// unless Strict is set, keeping the original error if that fails
+		// too.
+		relaxes := []func(string) (string, bool){softenCommas, dropFillerWords}
+		if cfg.strict {
+			relaxes = relaxes[:1]
+		}
+		for _, relax := range relaxes {
+			if cleaned, changed := relax(str); changed {
+				sub := newParsedDate()
+				if t, err := parseToTime(cleaned, opts, sub); err == nil {
+					*pd = *sub
+					return t, nil
+				}
+			}
+		}
+		// Strict names the filler word when it is what the input would
+		// parse without.
+		if cleaned, pos := dropFillers(str); cfg.strict && pos >= 0 {
+			if _, err := parseToTime(cleaned, opts, newParsedDate()); err == nil {
+				word, _, _ := strings.Cut(str[pos:], " ")
+				return time.Time{}, newParseError(str, word, pos, fmt.Errorf("%w: filler word %q at position %d", ErrStrict, word, pos))
+			}
+		}
+	}
+	if !ok {
+		if pd.cause == nil && pd.unread != "" {
+			return time.Time{}, newParseError(str, pd.unread, strings.LastIndex(str, pd.unread), newTrailingTextError(pd.unread))
+		}
+		if pd.cause != nil {
+			return time.Time{}, causeError(str, pd.cause)
+		}
+		if pos, _ := pd.firstErrorPos(); pos >= 0 {
+			if token := pd.tokenAt(pos); token != "" {
+				return time.Time{}, newParseError(str, token, pos, fmt.Errorf("%w %q at position %d", ErrUnexpectedToken, token, pos))
+			}
+		}
+		return time.Time{}, newParseError(str, "", 0, nil)
 	}
 	if pd.ErrorCount > 0 {
-		return time.Time{}, fmt.Errorf("unable to parse time string: %s: %s", str, pd.firstError())
+		pos, msg := pd.firstErrorPos()
+		return time.Time{}, newParseError(str, pd.tokenAt(pos), pos, errors.New(msg))
+	}
+	if pd.cause != nil {
+		return time.Time{}, causeError(str, pd.cause)
+	}
+	if cfg.strict && pd.WarningCount > 0 {
+		pos, msg := pd.firstWarning()
+		return time.Time{}, newParseError(str, pd.tokenAt(pos), pos, fmt.Errorf("%w: %s at position %d", ErrStrict, msg, pos))
+	}
+	// Year 0 needs YearZero, except in PHP's zero date "0000-00-00".
+	if pd.Year.Set && pd.Year.V == 0 && pd.Month.V != 0 && !cfg.yearZero {
+		return time.Time{}, newParseError(str, "", 0, NewInvalidDateError(0, pd.Month.V, pd.Day.V))
 	}
 	return pd.Materialize(now, loc)
 }
 
+// causeError returns the *ParseError for input failing with cause, taking
+// the text at fault from the errors that name it.
+func causeError(input string, cause error) error {
+	var pe *ParseError
+	if errors.As(cause, &pe) {
+		return newParseError(input, pe.Token, pe.Pos, pe.Err)
+	}
+	var ce *ConflictError
+	if errors.As(cause, &ce) {
+		return newParseError(input, ce.Second, ce.SecondPos, cause)
+	}
+	return newParseError(input, "", 0, cause)
+}
+
 // dispatchStrToTime runs the shared parse pipeline and returns true if any
 // stage matched. It is also the body of DateParse (with a zero base time).
 func dispatchStrToTime(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
-	if parseUnixTimestampInto(str, loc, pd) {
+	cfg := resolveSettings(opts)
+	pd.cfg = cfg
+	ok := dispatchStages(str, now, loc, opts, cfg, pd)
+	if cfg.rollover && (!ok || pd.ErrorCount > 0) && parseRolloverInto(str, now, loc, pd) {
+		pd.cfg = cfg
+		pd.format = "rollover"
+		ok = true
+	}
+	if ok && cfg.rollover {
+		pd.cause = nil
+	}
+	if !ok {
+		return false
+	}
+	if cfg.noTZDetection && pd.hasNamedZone() {
+		pd.AddError(0, "timezone detection is disabled")
+		return false
+	}
+	return true
+}
+
+// dispatchStages tries each stage of the parse pipeline in order.
+func dispatchStages(str string, now time.Time, loc *time.Location, opts []Option, cfg parseSettings, pd *ParsedDate) bool {
+	if parseCalendarNumericInto(str, now, loc, opts, pd) {
+		pd.format = "calendar_date"
+		return true
+	}
+	if cfg.extendedYears && parseExtendedYearInto(str, loc, pd) {
+		pd.format = "extended_year"
+		return true
+	}
+	if parseUnixTimestampInto(str, loc, cfg, pd) && acceptStage(cfg, pd) {
+		pd.format = "unix_timestamp"
 		return true
 	}
 	if parseKeywordInto(str, now, loc, pd) {
+		pd.format = "keyword"
 		return true
 	}
-	for _, parser := range formatParsers {
-		sub := newParsedDate()
-		if parser(str, now, loc, opts, sub) {
-			copyComponents(pd, sub)
-			if sub.hasMaterialized {
-				pd.setMaterialized(sub.materialized)
-			}
-			if sub.Relative != nil {
-				pd.Relative = sub.Relative
-			}
-			if sub.relativeApplied {
-				pd.relativeApplied = true
-			}
-			return true
-		}
+	if runFormatParsers(str, now, loc, opts, cfg, pd) {
+		return true
 	}
 	if parseDateWithRelativeTimeInto(str, now, loc, opts, pd) {
+		pd.format = "date_relative"
 		return true
 	}
 	if tryWeekdayPrefixReparseInto(str, now, loc, opts, pd) {
+		pd.format = "weekday_prefix"
 		return true
 	}
 	if isCompoundExpression(str) {
@@ -234,28 +420,46 @@ func dispatchStrToTime(str string, now time.Time, loc *time.Location, opts []Opt
 		// by accumulating into the Relative block without collapsing to an
 		// absolute time.
 		if parseCompoundRelativeInto(str, now, loc, opts, pd) {
+			pd.format = "compound_relative"
 			return true
 		}
 		if t, err := parseCompoundExpression(str, now, opts); err == nil {
 			pd.SetDate(t.Year(), int(t.Month()), t.Day())
 			pd.SetTime(t.Hour(), t.Minute(), t.Second())
 			pd.setMaterialized(t)
+			pd.format = "compound"
 			return true
 		} else {
 			pd.AddError(0, err.Error())
 			return false
 		}
 	}
-	if parseOrdinalDateInto(str, now, loc, pd) {
+	if parseOrdinalDateInto(str, now, loc, pd) && acceptStage(cfg, pd) {
+		pd.format = "ordinal_date"
 		return true
 	}
 
+	pd.tokens = tokenizeInto(pd.tokens[:0], str)
+	for i, tok := range pd.tokens {
+		// PHP reads "previous" as "last": "previous week", "monday previous week".
+		if tok.Val == "previous" {
+			pd.tokens[i].Val = DirectionLast
+		}
+	}
+	pd.kinds = classifyTokens(pd.kinds[:0], pd.tokens)
 	parser := &Parser{
-		tokens:   Tokenize(str),
-		position: 0,
-		result:   now,
-		loc:      loc,
-		pd:       pd,
+		tokens:    pd.tokens,
+		kinds:     pd.kinds,
+		position:  0,
+		result:    now,
+		loc:       loc,
+		pd:        pd,
+		noTZ:      cfg.noTZDetection,
+		months:    cfg.months,
+		days:      cfg.days,
+		weekStart: cfg.weekStart,
+		skipWords: cfg.skipWords,
+		strict:    cfg.strict,
 	}
 	result, err := parser.Parse()
 	if err != nil {
@@ -264,6 +468,13 @@ func dispatchStrToTime(str string, now time.Time, loc *time.Location, opts []Opt
 		if pd.ErrorCount == 0 {
 			pd.AddError(0, err.Error())
 		}
+		// Keep impossible dates and conflicting specifications
+		// distinguishable from unparseable text.
+		var de *InvalidDateError
+		var ce *ConflictError
+		if errors.As(err, &de) || errors.As(err, &ce) || errors.Is(err, ErrStrict) {
+			pd.cause = err
+		}
 		return false
 	}
 	// Token parser mutates p.result in place, so the returned time already
@@ -272,18 +483,51 @@ func dispatchStrToTime(str string, now time.Time, loc *time.Location, opts []Opt
 	// populated for DateParse reporting.
 	pd.setMaterialized(result)
 	pd.relativeApplied = true
-	return true
+	pd.format = "grammar"
+	return acceptStage(cfg, pd)
+}
+
+// acceptStage reports whether the match of a pipeline stage stands under
+// cfg. A stage that left text unread is discarded unless the Lenient option
+// is set, noting that text in pd for the error should no later stage match.
+func acceptStage(cfg parseSettings, pd *ParsedDate) bool {
+	if pd.leftover == "" || cfg.lenient {
+		return true
+	}
+	leftover, unread, cause := pd.leftover, pd.unread, pd.cause
+	pd.reset()
+	pd.cfg = cfg
+	pd.unread = unread
+	pd.cause = cause
+	pd.noteUnread(leftover)
+	return false
 }
 
 // Parser represents a token stream parser for time expressions
 type Parser struct {
 	tokens     []Token
+	kinds      []tokenKind // keyword kinds of tokens, see classifyTokens
 	position   int
 	result     time.Time
 	loc        *time.Location
-	tzFound    bool        // Flag to indicate if a timezone was parsed from the input
-	monthFound bool        // Flag to indicate if a month name was parsed (affects 4-digit number interpretation)
-	pd         *ParsedDate // optional; when non-nil, tryParse* methods populate components
+	tzFound    bool         // Flag to indicate if a timezone was parsed from the input
+	noTZ       bool         // Timezone names are not recognized (DisableTZDetection)
+	months     MonthPolicy  // Month arithmetic policy (MonthArithmetic)
+	days       DayPolicy    // Day arithmetic policy (DayArithmetic)
+	weekStart  time.Weekday // First day of the week (WeekStartsOn)
+	skipWords  bool         // Unknown words are skipped with a warning (Lenient)
+	strict     bool         // Tokens consumed by no expression are an error (Strict)
+	monthFound bool         // Flag to indicate if a month name was parsed (affects 4-digit number interpretation)
+	pd         *ParsedDate  // optional; when non-nil, tryParse* methods populate components
+	dateSpan   textSpan     // Expression that set the date, for reporting a second one
+	timeSpan   textSpan     // Expression that set the time, for reporting a second one
+	relSpan    textSpan     // Last relative expression, for reporting a repeated unit
+}
+
+// textSpan is a run of tokens, from index from up to index to. Its text is
+// only put together for the errors that report it, see spanText.
+type textSpan struct {
+	from, to int
 }
 
 // Parse processes the token stream and returns a time.Time result
@@ -291,8 +535,12 @@ func (p *Parser) Parse() (time.Time, error) {
 	// Skip any leading whitespace
 	p.skipWhitespace()
 
-	// Try standard date formats first
+	// Try standard date formats first. They make up the whole expression,
+	// and any tokens after them are left unread.
 	if t, ok, err := p.tryParseStandardDate(); ok {
+		if err == nil && p.pd != nil {
+			p.pd.leftover = p.rest()
+		}
 		return t, err
 	}
 
@@ -306,13 +554,24 @@ func (p *Parser) Parse() (time.Time, error) {
 		}
 
 		// Try to parse each expression type
+		start := p.position
 		parsed := false
 
-		// Try to parse timezone
-		if !p.tzFound {
+		// Try to parse timezone. Keywords are skipped unless they are also
+		// zone abbreviations, saving a zone lookup for every word.
+		if !p.tzFound && (p.kindAt(p.position)&kindKeyword == 0 || p.kindAt(p.position)&kindTZ != 0) {
 			if ok := p.tryParseTimezone(); ok {
 				parsed = true
 			}
+		} else if p.tzFound && p.kindAt(p.position)&kindTZ != 0 {
+			// PHP keeps the first zone and warns about the others.
+			if _, ok := tryParseTimezone(p.tokens[p.position].Val); ok {
+				if p.pd != nil {
+					p.pd.AddWarning(p.tokens[p.position].Pos, "Double timezone specification")
+				}
+				p.position++
+				parsed = true
+			}
 		}
 
 		// Try "first/last day of this/next/last month/year"
@@ -333,6 +592,7 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				p.relSpan = p.spanFrom(start)
 				parsed = true
 			}
 		}
@@ -355,6 +615,7 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				p.relSpan = p.spanFrom(start)
 				parsed = true
 			}
 		}
@@ -366,6 +627,7 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				p.relSpan = p.spanFrom(start)
 				parsed = true
 			}
 		}
@@ -388,6 +650,7 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				p.relSpan = p.spanFrom(start)
 				parsed = true
 			}
 		}
@@ -399,6 +662,9 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				if err := p.claim("time", &p.timeSpan, start); err != nil {
+					return time.Time{}, err
+				}
 				parsed = true
 			}
 		}
@@ -410,6 +676,20 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				if err := p.claim("time", &p.timeSpan, start); err != nil {
+					return time.Time{}, err
+				}
+				parsed = true
+			}
+		}
+
+		// Try "back of 7pm" / "front of 7pm"
+		if !parsed {
+			if t, ok := p.tryParseFrontBackOf(); ok {
+				p.result = t
+				if err := p.claim("time", &p.timeSpan, start); err != nil {
+					return time.Time{}, err
+				}
 				parsed = true
 			}
 		}
@@ -443,6 +723,9 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				if err := p.claim("date", &p.dateSpan, start); err != nil {
+					return time.Time{}, err
+				}
 				p.monthFound = true
 				parsed = true
 			}
@@ -455,6 +738,9 @@ func (p *Parser) Parse() (time.Time, error) {
 					return time.Time{}, err
 				}
 				p.result = t
+				if err := p.claim("date", &p.dateSpan, start); err != nil {
+					return time.Time{}, err
+				}
 				p.monthFound = true
 				parsed = true
 			}
@@ -471,10 +757,24 @@ func (p *Parser) Parse() (time.Time, error) {
 			}
 		}
 
+		// Some expressions consume tokens before finding they don't match,
+		// leaving them skipped.
+		if !parsed && p.strict && p.position > start {
+			text, pos := p.spanText(p.spanFrom(start))
+			return time.Time{}, &ParseError{Token: text, Pos: pos,
+				Err: fmt.Errorf("%w: unrecognized text %q at position %d", ErrStrict, text, pos)}
+		}
+
 		// Handle unrecognized token
 		if !parsed && p.position < len(p.tokens) {
 			currentToken := p.tokens[p.position]
 			p.position++
+			if currentToken.Typ == TypeString && p.skipWords {
+				if p.pd != nil {
+					p.pd.AddWarning(currentToken.Pos, "Unknown word skipped")
+				}
+				continue
+			}
 			if currentToken.Typ != TypeWhitespace {
 				// PHP quirk: the filler words "at"/"on" inside an otherwise
 				// valid expression are reported as unknown-TZ errors, not
@@ -492,7 +792,10 @@ func (p *Parser) Parse() (time.Time, error) {
 						p.pd.AddError(currentToken.Pos+i, "Unexpected character")
 					}
 				}
-				return time.Time{}, fmt.Errorf("unexpected token: %s", currentToken.Val)
+				if err := p.repeatedUnit(start); err != nil {
+					return time.Time{}, err
+				}
+				return time.Time{}, fmt.Errorf("%w %q at position %d", ErrUnexpectedToken, currentToken.Val, currentToken.Pos)
 			}
 		}
 
@@ -503,6 +806,78 @@ func (p *Parser) Parse() (time.Time, error) {
 	return p.result, nil
 }
 
+// rest returns the text of the tokens not yet consumed, without leading or
+// trailing whitespace.
+func (p *Parser) rest() string {
+	var b strings.Builder
+	for _, tok := range p.tokens[p.position:] {
+		b.WriteString(tok.Val)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// spanFrom returns the span of the tokens from index start up to the
+// current position.
+func (p *Parser) spanFrom(start int) textSpan {
+	return textSpan{from: start, to: p.position}
+}
+
+// spanText returns the text of the tokens of s and its byte offset.
+func (p *Parser) spanText(s textSpan) (string, int) {
+	var b strings.Builder
+	for _, tok := range p.tokens[s.from:s.to] {
+		b.WriteString(tok.Val)
+	}
+	return strings.TrimSpace(b.String()), p.tokens[s.from].Pos
+}
+
+// claim records the tokens from index start up to the current position as
+// the expression setting what, the date or the time. When an earlier
+// expression already set it, it adds PHP's "Double ... specification" error
+// and returns a *ConflictError naming both.
+func (p *Parser) claim(what string, first *textSpan, start int) error {
+	span := p.spanFrom(start)
+	if first.to == 0 {
+		*first = span
+		return nil
+	}
+	firstText, firstPos := p.spanText(*first)
+	text, pos := p.spanText(span)
+	if p.pd != nil {
+		p.pd.AddError(pos, "Double "+what+" specification")
+	}
+	return &ConflictError{What: what, First: firstText, FirstPos: firstPos, Second: text, SecondPos: pos}
+}
+
+// repeatedUnit returns a *ConflictError when the token at index start is a
+// time unit that the last relative expression already ended with, as in
+// "next month month".
+func (p *Parser) repeatedUnit(start int) error {
+	if p.relSpan.to == 0 || p.kindAt(start)&kindUnit == 0 {
+		return nil
+	}
+	tok := p.tokens[start]
+	unit := normalizeTimeUnit(tok.Val)
+	text, pos := p.spanText(p.relSpan)
+	last := text[strings.LastIndexByte(text, ' ')+1:]
+	if normalizeTimeUnit(last) != unit {
+		return nil
+	}
+	return &ConflictError{What: unit, First: text, FirstPos: pos, Second: tok.Val, SecondPos: tok.Pos}
+}
+
+// kindAt returns the keyword kinds of the token at index i, or 0 when i is
+// out of range.
+func (p *Parser) kindAt(i int) tokenKind {
+	if p.kinds == nil {
+		p.kinds = classifyTokens(nil, p.tokens)
+	}
+	if i < 0 || i >= len(p.kinds) {
+		return 0
+	}
+	return p.kinds[i]
+}
+
 // skipWhitespace advances the position past any whitespace tokens
 func (p *Parser) skipWhitespace() {
 	for p.position < len(p.tokens) && p.tokens[p.position].Typ == TypeWhitespace {
@@ -510,15 +885,65 @@ func (p *Parser) skipWhitespace() {
 	}
 }
 
+// inZoneWallClock returns the time with the date and time of day of t in
+// loc: a zone after a time ("10am EST") says how to read it, as in PHP.
+func inZoneWallClock(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.Date()
+	h, mi, s := t.Clock()
+	return time.Date(y, m, d, h, mi, s, t.Nanosecond(), loc)
+}
+
+// tryParseNumericOffset reads a UTC offset after another expression:
+// "+0200", "-05:00" or "+2" in "10am +0200" or "tomorrow -05:00". A signed
+// number followed by a word is left to the relative expressions, as in
+// "10am +2 hours".
+func (p *Parser) tryParseNumericOffset() bool {
+	end := p.position + 2
+	if p.position == 0 || end > len(p.tokens) || p.tokens[end-1].Typ != TypeNumber ||
+		(p.tokens[p.position].Val != "+" && p.tokens[p.position].Val != "-") {
+		return false
+	}
+	if end+1 < len(p.tokens) && p.tokens[end].Val == ":" && p.tokens[end+1].Typ == TypeNumber {
+		end += 2
+	}
+	next := end
+	for next < len(p.tokens) && p.tokens[next].Typ == TypeWhitespace {
+		next++
+	}
+	if next < len(p.tokens) && p.tokens[next].Typ == TypeString {
+		return false
+	}
+	var name string
+	for _, tok := range p.tokens[p.position:end] {
+		name += tok.Val
+	}
+	loc, ok := parseZoneField(name)
+	if !ok {
+		return false
+	}
+	p.loc = loc
+	p.tzFound = true
+	p.position = end
+	p.result = inZoneWallClock(p.result, p.loc)
+	if p.pd != nil {
+		p.pd.SetTZOffset(loc, computeOffsetSeconds(name))
+	}
+	return true
+}
+
 // tryParseTimezone attempts to parse a timezone from the token stream
 // This handles abbreviations (PST, EST), slash-separated paths (America/New_York,
 // America/Argentina/Buenos_Aires), hyphenated names (America/Port-au-Prince),
 // and multi-word names (Eastern Time).
 func (p *Parser) tryParseTimezone() bool {
-	if p.position >= len(p.tokens) {
+	if p.noTZ || p.position >= len(p.tokens) {
 		return false
 	}
 
+	if p.tokens[p.position].Typ == TypeOperator {
+		return p.tryParseNumericOffset()
+	}
+
 	// Must start with a string token
 	if p.tokens[p.position].Typ != TypeString {
 		return false
@@ -526,13 +951,35 @@ func (p *Parser) tryParseTimezone() bool {
 
 	startPos := p.position
 
+	// Offsets from GMT or UTC span several tokens: "gmt+3", "utc-05:30".
+	if end := p.position + 3; end <= len(p.tokens) &&
+		(p.tokens[end-2].Val == "+" || p.tokens[end-2].Val == "-") && p.tokens[end-1].Typ == TypeNumber {
+		if end+1 < len(p.tokens) && p.tokens[end].Val == ":" && p.tokens[end+1].Typ == TypeNumber {
+			end += 2
+		}
+		var name string
+		for _, tok := range p.tokens[p.position:end] {
+			name += tok.Val
+		}
+		if loc, ok := parseGMTOffsetZone(name); ok {
+			p.loc = loc
+			p.tzFound = true
+			p.position = end
+			p.result = inZoneWallClock(p.result, p.loc)
+			if p.pd != nil {
+				setTZFromName(p.pd, name, loc)
+			}
+			return true
+		}
+	}
+
 	// Try single token timezone first (EST, GMT, etc.)
 	tzString := p.tokens[p.position].Val
 	if loc, found := tryParseTimezone(tzString); found {
 		p.loc = loc
 		p.tzFound = true
 		p.position++
-		p.result = p.result.In(p.loc)
+		p.result = inZoneWallClock(p.result, p.loc)
 		if p.pd != nil {
 			setTZFromName(p.pd, tzString, loc)
 		}
@@ -569,7 +1016,7 @@ func (p *Parser) tryParseTimezone() bool {
 		p.loc = bestLoc
 		p.tzFound = true
 		p.position = bestPos
-		p.result = p.result.In(p.loc)
+		p.result = inZoneWallClock(p.result, p.loc)
 		if p.pd != nil {
 			setTZFromName(p.pd, bestName, bestLoc)
 		}
@@ -587,7 +1034,7 @@ func (p *Parser) tryParseTimezone() bool {
 			p.loc = loc
 			p.tzFound = true
 			p.position += 3
-			p.result = p.result.In(p.loc)
+			p.result = inZoneWallClock(p.result, p.loc)
 			if p.pd != nil {
 				setTZFromName(p.pd, tzString, loc)
 			}
@@ -638,6 +1085,10 @@ func (p *Parser) tryParseStandardDate() (time.Time, bool, error) {
 	if separator != p.tokens[p.position+3].Val {
 		return time.Time{}, false, nil
 	}
+	// "3.30.15pm" is a 12-hour time.
+	if separator == "." && p.meridianFollows(p.position+4) {
+		return time.Time{}, false, nil
+	}
 
 	// Determine the format based on the separators and numbers
 	var year, month, day int
@@ -667,6 +1118,9 @@ func (p *Parser) tryParseStandardDate() (time.Time, bool, error) {
 			year, month, day = firstNum, secondNum, thirdNum
 		} else if len(p.tokens[p.position+4].Val) >= 4 {
 			month, day, year = firstNum, secondNum, thirdNum
+		} else if len(p.tokens[p.position+4].Val) == 2 && len(p.tokens[p.position].Val) <= 2 {
+			// M/D/YY
+			month, day, year = firstNum, secondNum, parseTwoDigitYear(thirdNum)
 		} else {
 			return time.Time{}, false, nil
 		}
@@ -674,7 +1128,7 @@ func (p *Parser) tryParseStandardDate() (time.Time, bool, error) {
 		// European format: DD.MM.YY or DD.MM.YYYY
 		day, month, year = firstNum, secondNum, thirdNum
 		// Handle 2-digit years
-		if year < 100 {
+		if len(p.tokens[p.position+4].Val) <= 2 {
 			year = parseTwoDigitYear(year)
 		}
 	default:
@@ -683,7 +1137,7 @@ func (p *Parser) tryParseStandardDate() (time.Time, bool, error) {
 
 	// Validate date components using our utility function
 	if !IsValidDate(year, month, day) {
-		return time.Time{}, false, NewInvalidDateError(year, month, day)
+		return time.Time{}, true, NewInvalidDateError(year, month, day)
 	}
 
 	// Advance position past the parsed date
@@ -700,7 +1154,7 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 
 	// Check for "next", "last", or "this"
 	token := p.tokens[p.position]
-	if token.Typ != TypeString || (token.Val != DirectionNext && token.Val != DirectionLast && token.Val != "this") {
+	if p.kindAt(p.position)&kindDirection == 0 {
 		return time.Time{}, false, nil
 	}
 
@@ -722,31 +1176,29 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 	p.position++
 
 	// Handle special case: "next week" and "last week"
-	// PHP treats Monday as the first day of the week.
+	// PHP treats Monday as the first day of the week; WeekStartsOn changes it.
 	if unitToken.Val == UnitWeek {
 		if p.pd != nil {
 			// PHP represents "next/last/this week" as weekday=1 (Monday)
 			// plus a +/-7 day offset.
-			p.pd.SetRelativeWeekday(1)
+			p.pd.SetRelativeWeekday(int(p.weekStart))
 			if isNext {
 				p.pd.AddRelative(UnitDay, 7)
 			} else if !isThis {
 				p.pd.AddRelative(UnitDay, -7)
 			}
 		}
-		dayOfWeek := int(p.result.Weekday())
-		// Days since this week's Monday (Go weekday: 0=Sun,1=Mon,...,6=Sat)
-		daysSinceMonday := (dayOfWeek + 6) % 7
+		daysIntoWeek := daysSinceWeekStart(p.result.Weekday(), p.weekStart)
 
 		if isNext {
-			// Next week = next Monday (always 1-7 days ahead)
-			return p.result.AddDate(0, 0, 7-daysSinceMonday), true, nil
+			// Next week = the next week start (always 1-7 days ahead)
+			return p.result.AddDate(0, 0, 7-daysIntoWeek), true, nil
 		} else if isThis {
-			// This week = this Monday
-			return p.result.AddDate(0, 0, -daysSinceMonday), true, nil
+			// This week = the start of this week
+			return p.result.AddDate(0, 0, -daysIntoWeek), true, nil
 		} else {
-			// Last week = previous week's Monday (always 7-13 days back)
-			return p.result.AddDate(0, 0, -(daysSinceMonday + 7)), true, nil
+			// Last week = the start of the previous week (always 7-13 days back)
+			return p.result.AddDate(0, 0, -(daysIntoWeek + 7)), true, nil
 		}
 	}
 
@@ -803,12 +1255,43 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 			}
 		}
 		if isNext {
-			return p.result.AddDate(0, 0, 1), true, nil
+			return addDays(p.result, 1, p.days), true, nil
 		}
 		if isThis {
 			return p.result, true, nil
 		}
-		return p.result.AddDate(0, 0, -1), true, nil
+		return addDays(p.result, -1, p.days), true, nil
+	case UnitWeekDay, "weekdays":
+		// PHP resets the time for "next weekday", as for "next monday".
+		amount := -1
+		if isNext {
+			amount = 1
+		} else if isThis {
+			amount = 0
+		}
+		if p.pd != nil {
+			p.pd.AddRelative(UnitWeekDay, amount)
+			p.pd.SetTime(0, 0, 0)
+		}
+		t := addWeekdays(p.result, amount)
+		year, month, day := t.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, p.loc), true, nil
+	case UnitFortnight, "forthnight":
+		if p.pd != nil {
+			p.pd.relative()
+			if isNext {
+				p.pd.AddRelative(UnitFortnight, 1)
+			} else if !isThis {
+				p.pd.AddRelative(UnitFortnight, -1)
+			}
+		}
+		if isNext {
+			return addDays(p.result, 14, p.days), true, nil
+		}
+		if isThis {
+			return p.result, true, nil
+		}
+		return addDays(p.result, -14, p.days), true, nil
 	case UnitMonth:
 		if p.pd != nil {
 			// PHP always emits the relative block for this/next/last X.
@@ -819,11 +1302,14 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 				p.pd.AddRelative(UnitMonth, -1)
 			}
 		}
+		n := -1
 		if isNext {
-			return p.result.AddDate(0, 1, 0), true, nil
-		} else {
-			return p.result.AddDate(0, -1, 0), true, nil
+			n = 1
 		}
+		if err := monthOverflow(p.result, n, p.months); err != nil {
+			return time.Time{}, true, err
+		}
+		return addMonths(p.result, n, p.months), true, nil
 	case UnitYear:
 		if p.pd != nil {
 			p.pd.relative()
@@ -833,11 +1319,14 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 				p.pd.AddRelative(UnitYear, -1)
 			}
 		}
+		n := -12
 		if isNext {
-			return p.result.AddDate(1, 0, 0), true, nil
-		} else {
-			return p.result.AddDate(-1, 0, 0), true, nil
+			n = 12
+		}
+		if err := monthOverflow(p.result, n, p.months); err != nil {
+			return time.Time{}, true, err
 		}
+		return addMonths(p.result, n, p.months), true, nil
 	case UnitHour, UnitMinute, UnitSecond:
 		if p.pd != nil {
 			p.pd.relative()
@@ -861,112 +1350,101 @@ func (p *Parser) tryParseNextLastExpression() (time.Time, bool, error) {
 
 // daysInMonth returns the number of days in a given month and year
 
-// isCompoundExpression checks if a string is a compound time expression (contains + or - in the middle)
+// isCompoundExpression checks if a string is a compound time expression:
+// one with a +/- operator token after its first token, other than a trailing
+// numeric zone offset (" +0500", " -03:00") or that of "gmt+3". Only the operators reported by
+// compoundOperators are used to split it into terms.
 func isCompoundExpression(str string) bool {
-	// Don't classify a numeric timezone suffix (+09:00, -0500) as a compound
-	// expression. A trailing " +HHMM" / " +HH:MM" / " -HHMM" is a TZ marker.
-	if idx := strings.LastIndexAny(str, "+-"); idx > 0 && str[idx-1] == ' ' {
-		tail := str[idx:]
-		if _, _, ok := parseNumericTimezoneOffset(tail); ok {
-			trimmed := strings.TrimSpace(str[:idx])
-			// If the prefix contains no other +/- in the middle, this isn't
-			// a compound expression.
-			if !containsInfixSign(trimmed) {
-				return false
+	var buf [32]Token
+	toks := tokenizeInto(buf[:0], str)
+	for i := 1; i < len(toks); i++ {
+		if toks[i].Typ != TypeOperator || strings.Trim(toks[i].Val, "+-") != "" {
+			continue
+		}
+		if toks[i-1].Typ == TypeWhitespace {
+			if _, _, ok := parseNumericTimezoneOffset(str[toks[i].Pos:]); ok {
+				continue
 			}
 		}
-	}
-
-	// Normalize spaces around operators
-	spaceOperatorRe := strings.NewReplacer(" + ", "+", " - ", "-", "+ ", "+", "- ", "-")
-	normalizedStr := spaceOperatorRe.Replace(str)
-
-	// Check if we have + or - in the middle of the string (not at the start)
-	return (strings.Contains(normalizedStr, "+") && !strings.HasPrefix(normalizedStr, "+")) ||
-		(strings.Contains(normalizedStr, "-") && !strings.HasPrefix(normalizedStr, "-"))
-}
-
-// containsInfixSign reports whether s has a '+' or '-' after position 0.
-func containsInfixSign(s string) bool {
-	for i := 1; i < len(s); i++ {
-		if s[i] == '+' || s[i] == '-' {
-			return true
+		if toks[i-1].Typ == TypeString {
+			zone, _, _ := strings.Cut(str[toks[i-1].Pos:], " ")
+			if _, ok := parseGMTOffsetZone(zone); ok {
+				continue
+			}
 		}
+		return true
 	}
 	return false
 }
 
-// parseDateWithRelativeTime parses a date followed by a relative time adjustment
-// Examples: "2023-05-30 -1 month" or "2022-01-01 +1 year"
-func parseDateWithRelativeTime(str string, now time.Time, loc *time.Location, opts []Option) (time.Time, bool) {
-	// Split on first whitespace to get date part and rest
-	datePart, timePart, ok := splitDateAndRest(str)
-	if !ok {
-		return time.Time{}, false
-	}
+// compoundOperators appends to dst the byte offsets of the +/- operators of
+// str that join two terms of a compound expression, and returns the extended
+// slice. Such an operator is not the first token of str and is followed by
+// an amount and a unit ("next year + 4 days", "tomorrow-1hour"), so dashes
+// inside dates ("2023-01-15", "jan-15-2006") and numeric zone offsets
+// ("+0500") are never mistaken for one.
+func compoundOperators(str string, dst []int) []int {
+	var buf [32]Token
+	toks := tokenizeInto(buf[:0], str)
+	for i := 1; i < len(toks); i++ {
+		if toks[i].Typ != TypeOperator || strings.Trim(toks[i].Val, "+-") != "" {
+			continue
+		}
+		j := skipWhitespaceTokens(toks, i+1)
+		if j >= len(toks) || toks[j].Typ != TypeNumber {
+			continue
+		}
+		k := skipWhitespaceTokens(toks, j+1)
+		if k < len(toks) && toks[k].Typ == TypeString && isRelativeUnitWord(toks[k].Val) {
+			dst = append(dst, toks[i].Pos)
+		}
+	}
+	return dst
+}
 
-	// Parse the date part
-	dateResult, err := StrToTime(datePart, append(opts, Rel(now))...)
-	if err != nil {
-		return time.Time{}, false
+// skipWhitespaceTokens returns the index of the first non-whitespace token
+// of toks at or after i.
+func skipWhitespaceTokens(toks []Token, i int) int {
+	for i < len(toks) && toks[i].Typ == TypeWhitespace {
+		i++
 	}
+	return i
+}
 
-	// Handle special case for month end dates when subtracting months
-	if timePart == "-1 month" {
-		year, month, day := dateResult.Date()
-
-		// Check if it's the last day of the month
-		if day == daysInMonth(year, month) {
-			// Create a date for the first day of the current month
-			firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, loc)
-			// Subtract one day to get the last day of the previous month
-			prevMonth := firstOfMonth.AddDate(0, -1, 0)
-			// Get the last day of the previous month
-			lastDay := daysInMonth(prevMonth.Year(), prevMonth.Month())
-
-			// Create the final date with the last day of the previous month,
-			// preserving hour, minute, second from the original date
-			return time.Date(prevMonth.Year(), prevMonth.Month(), lastDay,
-				dateResult.Hour(), dateResult.Minute(), dateResult.Second(),
-				dateResult.Nanosecond(), loc), true
-		}
+// isRelativeUnitWord reports whether w can follow the amount of a relative
+// term: a time unit ("days", "hrs") or a weekday ("+1 monday").
+func isRelativeUnitWord(w string) bool {
+	if classifyWord(w)&(kindUnit|kindWeekday) != 0 {
+		return true
 	}
-
-	// Parse the time part using the date as reference
-	finalResult, err := StrToTime(timePart, append(opts, Rel(dateResult))...)
-	if err != nil {
-		return time.Time{}, false
+	switch normalizeTimeUnit(w) {
+	case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear, UnitHour, UnitMinute, UnitSecond:
+		return true
 	}
-
-	return finalResult, true
+	return false
 }
 
 // parseCompoundExpression parses a compound time expression like "next year+4 days"
 func parseCompoundExpression(str string, now time.Time, opts []Option) (time.Time, error) {
-	// Normalize compound expressions like "next year+4 days" or "next year + 4 days"
-	// Replace spaces around + and - with nothing to make parsing easier
-	spaceOperatorRe := strings.NewReplacer(" + ", "+", " - ", "-", "+ ", "+", "- ", "-")
-	normalizedStr := spaceOperatorRe.Replace(str)
+	// Split the string at the operators joining its terms, dropping the
+	// spaces around them: "next year + 4 days" gives "next year" and "4 days".
+	var buf [8]int
+	offsets := compoundOperators(str, buf[:0])
 
-	// Split the string at + and - operators
 	var parts []string
 	var operators []string
-
-	// Find all + and - operators (not at the beginning)
-	currentPart := ""
-	for i := 0; i < len(normalizedStr); i++ {
-		if (normalizedStr[i] == '+' || normalizedStr[i] == '-') && i > 0 {
-			parts = append(parts, currentPart)
-			operators = append(operators, string(normalizedStr[i]))
-			currentPart = ""
-		} else {
-			currentPart += string(normalizedStr[i])
+	start := 0
+	for _, off := range offsets {
+		end := off
+		for end < len(str) && (str[end] == '+' || str[end] == '-') {
+			end++
 		}
+		parts = append(parts, strings.TrimSpace(str[start:off]))
+		operators = append(operators, str[off:end])
+		start = end
 	}
-
-	// Add the last part
-	if currentPart != "" {
-		parts = append(parts, currentPart)
+	if start < len(str) {
+		parts = append(parts, strings.TrimSpace(str[start:]))
 	}
 
 	// Validate that we have at least one part and one operator
@@ -975,11 +1453,29 @@ func parseCompoundExpression(str string, now time.Time, opts []Option) (time.Tim
 	}
 
 	// Process the first part
-	result, err := StrToTime(parts[0], append(opts, Rel(now))...)
+	result, err := parseToTime(parts[0], append(opts, Rel(now)), newParsedDate())
 	if err != nil {
 		return time.Time{}, err
 	}
 
+	// When every remaining operand is a plain "N unit" offset, accumulate
+	// them into a single relative block and apply it once on top of the
+	// first part, so the outcome doesn't depend on the order the offsets
+	// were written in.
+	if len(parts) == len(operators)+1 {
+		rel := &Relative{}
+		pure := true
+		for i, op := range operators {
+			if !addRelativeTerm(rel, op+parts[i+1]) {
+				pure = false
+				break
+			}
+		}
+		if pure {
+			return applyRelative(result, rel, result.Location(), resolveSettings(opts))
+		}
+	}
+
 	// Process each remaining part with its operator
 	for i := 0; i < len(operators); i++ {
 		// Check if we have a corresponding part for this operator
@@ -989,7 +1485,7 @@ func parseCompoundExpression(str string, now time.Time, opts []Option) (time.Tim
 
 		// Apply the operator to the part
 		opPart := operators[i] + parts[i+1]
-		nextResult, err := StrToTime(opPart, append(opts, Rel(result))...)
+		nextResult, err := parseToTime(opPart, append(opts, Rel(result)), newParsedDate())
 		if err != nil {
 			return time.Time{}, err
 		}
@@ -999,14 +1495,41 @@ func parseCompoundExpression(str string, now time.Time, opts []Option) (time.Tim
 	return result, nil
 }
 
+// isOffsetUnit reports whether unit is a time unit applyTimeUnitOffset
+// applies.
+func isOffsetUnit(unit string) bool {
+	switch normalizeTimeUnit(unit) {
+	case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear, UnitHour, UnitMinute, UnitSecond:
+		return true
+	}
+	return false
+}
+
 // applyTimeUnitOffset applies a time unit offset to the parser's result time.
 func (p *Parser) applyTimeUnitOffset(amount int, unitStr string) (time.Time, error) {
 	canonical := normalizeTimeUnit(unitStr)
 	switch canonical {
-	case UnitDay, UnitWeek, UnitWeekDay, UnitMonth, UnitYear, UnitHour, UnitMinute, UnitSecond:
+	case UnitDay, UnitWeek, UnitFortnight, UnitWeekDay, UnitMonth, UnitYear, UnitHour, UnitMinute, UnitSecond:
 		if p.pd != nil {
 			p.pd.AddRelative(canonical, amount)
 		}
+		switch canonical {
+		case UnitDay:
+			return addDays(p.result, amount, p.days), nil
+		case UnitWeek:
+			return addDays(p.result, amount*7, p.days), nil
+		case UnitFortnight:
+			return addDays(p.result, amount*14, p.days), nil
+		case UnitMonth, UnitYear:
+			n := amount
+			if canonical == UnitYear {
+				n *= 12
+			}
+			if err := monthOverflow(p.result, n, p.months); err != nil {
+				return time.Time{}, err
+			}
+			return addMonths(p.result, n, p.months), nil
+		}
 		return applyTimeOffset(p.result, amount, unitStr), nil
 	default:
 		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidTimeUnit, unitStr)
@@ -1075,7 +1598,7 @@ func (p *Parser) tryParseRelativeTime() (time.Time, bool, error) {
 	// Process the unit by calling the common helper function
 	result, err := p.applyTimeUnitOffset(amount, unitToken.Val)
 	if err != nil {
-		return time.Time{}, false, err
+		return time.Time{}, errors.Is(err, ErrInvalidDate), err
 	}
 
 	return result, true, nil
@@ -1114,7 +1637,7 @@ func (p *Parser) tryParseImplicitRelativeTime() (time.Time, bool, error) {
 	}
 
 	unitToken := p.tokens[p.position]
-	if unitToken.Typ != TypeString {
+	if unitToken.Typ != TypeString || !isOffsetUnit(unitToken.Val) {
 		p.position = startPos
 		return time.Time{}, false, nil
 	}
@@ -1133,6 +1656,9 @@ func (p *Parser) tryParseImplicitRelativeTime() (time.Time, bool, error) {
 
 	// Process the unit by calling the common helper function
 	result, err := p.applyTimeUnitOffset(amount, unitToken.Val)
+	if errors.Is(err, ErrInvalidDate) {
+		return time.Time{}, true, err
+	}
 	if err != nil {
 		p.position = startPos
 		return time.Time{}, false, nil
@@ -1177,15 +1703,10 @@ func (p *Parser) tryParseMonthOnlyFormat() (time.Time, bool, error) {
 	}
 
 	// Check for a month name
-	monthToken := p.tokens[p.position]
-	if monthToken.Typ != TypeString {
-		return time.Time{}, false, nil
-	}
-
-	month, ok := getMonthByName(monthToken.Val)
-	if !ok {
+	if p.kindAt(p.position)&kindMonth == 0 {
 		return time.Time{}, false, nil
 	}
+	month, _ := getMonthByName(p.tokens[p.position].Val)
 
 	// Consume the month token
 	p.position++
@@ -1199,6 +1720,9 @@ func (p *Parser) tryParseMonthOnlyFormat() (time.Time, bool, error) {
 	if day > maxDays {
 		day = maxDays
 	}
+	if p.pd != nil {
+		p.pd.SetMonth(int(month))
+	}
 
 	return time.Date(year, month, day, 0, 0, 0, 0, p.loc), true, nil
 }
@@ -1210,15 +1734,10 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 	}
 
 	// Check for a month name
-	monthToken := p.tokens[p.position]
-	if monthToken.Typ != TypeString {
-		return time.Time{}, false, nil
-	}
-
-	month, ok := getMonthByName(monthToken.Val)
-	if !ok {
+	if p.kindAt(p.position)&kindMonth == 0 {
 		return time.Time{}, false, nil
 	}
+	month, _ := getMonthByName(p.tokens[p.position].Val)
 	p.position++
 
 	// Skip optional period after month abbreviation (e.g., "Dec.")
@@ -1269,7 +1788,7 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 			// If so, don't consume it as a year
 			isTime := p.position+1 < len(p.tokens) &&
 				p.tokens[p.position+1].Typ == TypeOperator &&
-				p.tokens[p.position+1].Val == ":"
+				p.tokens[p.position+1].Val == ":" || p.meridianFollows(p.position)
 			if !isTime {
 				yearVal, err := strconv.Atoi(yearToken.Val)
 				if err != nil {
@@ -1284,7 +1803,7 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 
 	// Validate date components before returning
 	if !IsValidDate(year, int(month), day) {
-		return time.Time{}, false, fmt.Errorf("invalid date: %s %d, %d", month, day, year)
+		return time.Time{}, true, NewInvalidDateError(year, int(month), day)
 	}
 	if p.pd != nil {
 		if yearFromInput {
@@ -1297,9 +1816,10 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 
 	// Default time components
 	hour, minute, second := 0, 0, 0
+	fraction, hasFraction := 0.0, false
 
 	// Check for time (optional)
-	// Format: HH:MM:SS
+	// Format: HH:MM:SS[.frac]
 	p.skipWhitespace()
 	if p.position+2 < len(p.tokens) &&
 		p.tokens[p.position].Typ == TypeNumber && // HH
@@ -1328,6 +1848,7 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 					if err == nil && secondVal >= 0 && secondVal <= 59 {
 						second = secondVal
 						p.position++
+						fraction, hasFraction = p.tryParseFraction()
 					}
 				}
 
@@ -1358,8 +1879,11 @@ func (p *Parser) tryParseMonthNameFormat() (time.Time, bool, error) {
 	if p.pd != nil && (hour != 0 || minute != 0 || second != 0) {
 		p.pd.SetTime(hour, minute, second)
 	}
+	if p.pd != nil && hasFraction {
+		p.pd.SetFraction(fraction)
+	}
 
-	return time.Date(year, month, day, hour, minute, second, 0, p.loc), true, nil
+	return time.Date(year, month, day, hour, minute, second, int(fraction*1e9), p.loc), true, nil
 }
 
 // getMonthByName converts a month name to its number
@@ -1373,15 +1897,10 @@ func (p *Parser) tryParseBareWeekday() (time.Time, bool, error) {
 		return time.Time{}, false, nil
 	}
 
-	token := p.tokens[p.position]
-	if token.Typ != TypeString {
-		return time.Time{}, false, nil
-	}
-
-	dayNum := getDayOfWeek(token.Val)
-	if dayNum < 0 {
+	if p.kindAt(p.position)&kindWeekday == 0 {
 		return time.Time{}, false, nil
 	}
+	dayNum := getDayOfWeek(p.tokens[p.position].Val)
 
 	p.position++
 	p.skipWhitespace()
@@ -1389,7 +1908,7 @@ func (p *Parser) tryParseBareWeekday() (time.Time, bool, error) {
 	// Check for "next/last/this week" after weekday
 	if p.position < len(p.tokens) && p.tokens[p.position].Typ == TypeString {
 		direction := p.tokens[p.position].Val
-		if direction == DirectionNext || direction == DirectionLast || direction == "this" {
+		if p.kindAt(p.position)&kindDirection != 0 {
 			savedPos := p.position
 			p.position++
 			p.skipWhitespace()
@@ -1398,22 +1917,21 @@ func (p *Parser) tryParseBareWeekday() (time.Time, bool, error) {
 				p.tokens[p.position].Val == UnitWeek {
 				p.position++
 
-				// Calculate: find the Monday of next/last/this week, then offset to target weekday
-				currentDay := int(p.result.Weekday())
-				daysSinceMonday := (currentDay + 6) % 7
+				// Calculate: find the start of next/last/this week, then offset to target weekday
+				daysIntoWeek := daysSinceWeekStart(p.result.Weekday(), p.weekStart)
 
-				var monday time.Time
+				var weekStart time.Time
 				if direction == DirectionNext {
-					monday = p.result.AddDate(0, 0, 7-daysSinceMonday)
+					weekStart = p.result.AddDate(0, 0, 7-daysIntoWeek)
 				} else if direction == "this" {
-					monday = p.result.AddDate(0, 0, -daysSinceMonday)
+					weekStart = p.result.AddDate(0, 0, -daysIntoWeek)
 				} else {
-					monday = p.result.AddDate(0, 0, -(daysSinceMonday + 7))
+					weekStart = p.result.AddDate(0, 0, -(daysIntoWeek + 7))
 				}
 
-				// Offset from Monday to target weekday (Mon=0, Tue=1, ..., Sun=6)
-				targetOffset := (dayNum + 6) % 7
-				result := monday.AddDate(0, 0, targetOffset)
+				// Offset from the week start to target weekday
+				targetOffset := daysSinceWeekStart(time.Weekday(dayNum), p.weekStart)
+				result := weekStart.AddDate(0, 0, targetOffset)
 
 				year, month, day := result.Date()
 				hour, minute, second := 0, 0, 0
@@ -1519,69 +2037,28 @@ func (p *Parser) tryParseFirstLastDayOfExpression() (time.Time, bool, error) {
 	p.position++
 	p.skipWhitespace()
 
-	// Must be followed by "this"/"next"/"last"
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
-		p.position = startPos
-		return time.Time{}, false, nil
-	}
-
-	direction := p.tokens[p.position].Val
-	if direction != "this" && direction != DirectionNext && direction != DirectionLast {
-		p.position = startPos
-		return time.Time{}, false, nil
-	}
-	p.position++
-	p.skipWhitespace()
-
-	// Must be followed by "month" or "year"
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+	// The day is set after the month and year offsets that follow, which
+	// only move the month: "first day of next month", "last day of 2 months
+	// ago", "first day of -1 year". Alone, it is in the current month.
+	years, months, ok := p.readMonthOffset()
+	if !ok {
 		p.position = startPos
 		return time.Time{}, false, nil
 	}
-
-	unit := normalizeTimeUnit(p.tokens[p.position].Val)
-	p.position++
-
-	year := p.result.Year()
-	month := p.result.Month()
-
-	switch unit {
-	case UnitMonth:
-		// Use day=1 as reference to avoid overflow (e.g., Jan 31 + 1 month
-		// would produce March 2, but "first day of next month" must land in Feb).
-		firstOfCurrent := time.Date(year, month, 1, 0, 0, 0, 0, p.loc)
-		if direction == DirectionNext {
-			ref := firstOfCurrent.AddDate(0, 1, 0)
-			year, month, _ = ref.Date()
-			if p.pd != nil {
-				p.pd.AddRelative(UnitMonth, 1)
-			}
-		} else if direction == DirectionLast {
-			ref := firstOfCurrent.AddDate(0, -1, 0)
-			year, month, _ = ref.Date()
-			if p.pd != nil {
-				p.pd.AddRelative(UnitMonth, -1)
-			}
+	if p.pd != nil {
+		if years != 0 {
+			p.pd.AddRelative(UnitYear, years)
 		}
-	case UnitYear:
-		if direction == DirectionNext {
-			year = p.result.Year() + 1
-			month = time.January
-			if p.pd != nil {
-				p.pd.AddRelative(UnitYear, 1)
-			}
-		} else if direction == DirectionLast {
-			year = p.result.Year() - 1
-			month = time.December
-			if p.pd != nil {
-				p.pd.AddRelative(UnitYear, -1)
-			}
+		if months != 0 {
+			p.pd.AddRelative(UnitMonth, months)
 		}
-	default:
-		p.position = startPos
-		return time.Time{}, false, nil
 	}
 
+	// Use day=1 as reference to avoid overflow (e.g., Jan 31 + 1 month
+	// would produce March 2, but "first day of next month" must land in Feb).
+	ref := time.Date(p.result.Year(), p.result.Month(), 1, 0, 0, 0, 0, p.loc).AddDate(years, months, 0)
+	year, month, _ := ref.Date()
+
 	var day int
 	if isFirst {
 		day = 1
@@ -1600,12 +2077,85 @@ func (p *Parser) tryParseFirstLastDayOfExpression() (time.Time, bool, error) {
 	return time.Date(year, month, day, p.result.Hour(), p.result.Minute(), p.result.Second(), 0, p.loc), true, nil
 }
 
+// readMonthOffset reads the offset after "first day of" or "last day of":
+// "this", "next", "last" or "previous" and a month or year unit, or an
+// amount of months or years, signed or followed by "ago". Nothing is read,
+// and no offset returned, at the end of the input.
+func (p *Parser) readMonthOffset() (years, months int, ok bool) {
+	if p.position >= len(p.tokens) {
+		return 0, 0, true
+	}
+	startPos := p.position
+	tok := p.tokens[p.position]
+
+	amount := 0
+	switch {
+	case tok.Typ == TypeString && (tok.Val == "this" || tok.Val == DirectionNext || tok.Val == DirectionLast || tok.Val == "previous"):
+		switch tok.Val {
+		case DirectionNext:
+			amount = 1
+		case DirectionLast, "previous":
+			amount = -1
+		}
+		p.position++
+	case tok.Typ == TypeOperator && (tok.Val == "+" || tok.Val == "-"),
+		tok.Typ == TypeNumber:
+		sign := 1
+		if tok.Typ == TypeOperator {
+			if tok.Val == "-" {
+				sign = -1
+			}
+			p.position++
+		}
+		if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeNumber {
+			p.position = startPos
+			return 0, 0, false
+		}
+		n, err := strconv.Atoi(p.tokens[p.position].Val)
+		if err != nil {
+			p.position = startPos
+			return 0, 0, false
+		}
+		amount = sign * n
+		p.position++
+	default:
+		return 0, 0, false
+	}
+	p.skipWhitespace()
+
+	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+		p.position = startPos
+		return 0, 0, false
+	}
+	unit := normalizeTimeUnit(p.tokens[p.position].Val)
+	if unit != UnitMonth && unit != UnitYear {
+		p.position = startPos
+		return 0, 0, false
+	}
+	p.position++
+
+	if tok.Typ != TypeString {
+		agoPos := p.position
+		p.skipWhitespace()
+		if p.position < len(p.tokens) && p.tokens[p.position].Typ == TypeString && p.tokens[p.position].Val == "ago" {
+			amount = -amount
+			p.position++
+		} else {
+			p.position = agoPos
+		}
+	}
+	if unit == UnitYear {
+		return amount, 0, true
+	}
+	return 0, amount, true
+}
+
 // tryParseDayKeyword handles "tomorrow" / "yesterday" / "today" / "now"
 // keywords when they appear as a token in the stream. PHP combines them with
 // other tokens by applying a day offset to the relative block and resetting
 // hour/minute/second/fraction to 0.
 func (p *Parser) tryParseDayKeyword() (time.Time, bool, error) {
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+	if p.kindAt(p.position)&kindDayKeyword == 0 {
 		return time.Time{}, false, nil
 	}
 	switch p.tokens[p.position].Val {
@@ -1618,7 +2168,7 @@ func (p *Parser) tryParseDayKeyword() (time.Time, bool, error) {
 			r := p.pd.relative()
 			r.Day = 1
 		}
-		return p.result.AddDate(0, 0, 1), true, nil
+		return midnightAfter(p.result, 1, p.days, p.loc), true, nil
 	case "yesterday":
 		p.position++
 		if p.pd != nil {
@@ -1627,7 +2177,7 @@ func (p *Parser) tryParseDayKeyword() (time.Time, bool, error) {
 			r := p.pd.relative()
 			r.Day = -1
 		}
-		return p.result.AddDate(0, 0, -1), true, nil
+		return midnightAfter(p.result, -1, p.days, p.loc), true, nil
 	case "today":
 		p.position++
 		if p.pd != nil {
@@ -1645,7 +2195,7 @@ func (p *Parser) tryParseDayKeyword() (time.Time, bool, error) {
 
 // tryParseTimeKeyword handles "midnight" and "noon" keywords in token stream
 func (p *Parser) tryParseTimeKeyword() (time.Time, bool, error) {
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+	if p.kindAt(p.position)&kindTimeKeyword == 0 {
 		return time.Time{}, false, nil
 	}
 
@@ -1670,6 +2220,56 @@ func (p *Parser) tryParseTimeKeyword() (time.Time, bool, error) {
 	}
 }
 
+// tryParseFrontBackOf handles "back of 7pm", a quarter past the hour, and
+// "front of 7pm", a quarter to it, on the current date.
+func (p *Parser) tryParseFrontBackOf() (time.Time, bool) {
+	if p.position >= len(p.tokens) {
+		return time.Time{}, false
+	}
+	word := p.tokens[p.position].Val
+	if word != "back" && word != "front" {
+		return time.Time{}, false
+	}
+	startPos := p.position
+	p.position++
+	p.skipWhitespace()
+	if p.position >= len(p.tokens) || p.tokens[p.position].Val != "of" {
+		p.position = startPos
+		return time.Time{}, false
+	}
+	p.position++
+	p.skipWhitespace()
+	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeNumber || len(p.tokens[p.position].Val) > 2 {
+		p.position = startPos
+		return time.Time{}, false
+	}
+	hour := digitsValue(p.tokens[p.position].Val)
+	p.position++
+
+	ampm := ""
+	afterHour := p.position
+	p.skipWhitespace()
+	if p.position < len(p.tokens) && (p.tokens[p.position].Val == "am" || p.tokens[p.position].Val == "pm") {
+		ampm = p.tokens[p.position].Val
+		p.position++
+	} else {
+		p.position = afterHour
+	}
+	if hour > 24 || ampm != "" && (hour < 1 || hour > 12) {
+		p.position = startPos
+		return time.Time{}, false
+	}
+
+	hour, minute := frontBackOfTime(word == "front", hour, ampm)
+	year, month, day := p.result.Date()
+	t := time.Date(year, month, day, hour, minute, 0, 0, p.loc)
+	if p.pd != nil {
+		p.pd.SetTime(t.Hour(), t.Minute(), 0)
+		p.pd.SetFraction(0)
+	}
+	return t, true
+}
+
 // tryParseWeekdayAgo handles "N weekday ago" or "N weekdays ago"
 func (p *Parser) tryParseWeekdayAgo() (time.Time, bool, error) {
 	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeNumber {
@@ -1733,14 +2333,40 @@ func (p *Parser) tryParseWeekdayAgo() (time.Time, bool, error) {
 	return time.Date(year, month, day, p.result.Hour(), p.result.Minute(), p.result.Second(), p.result.Nanosecond(), p.loc), true, nil
 }
 
+// tryParseFraction reads the fractional seconds after a time: a period
+// followed by digits, or a period with no digits before whitespace or the
+// end of the input, as database exports write "10:30:45.", which is an empty
+// fraction.
+func (p *Parser) tryParseFraction() (float64, bool) {
+	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeOperator || p.tokens[p.position].Val != "." {
+		return 0, false
+	}
+	if p.position+1 == len(p.tokens) || p.tokens[p.position+1].Typ == TypeWhitespace {
+		p.position++
+		return 0, true
+	}
+	if p.tokens[p.position+1].Typ != TypeNumber {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat("0."+p.tokens[p.position+1].Val, 64)
+	if err != nil {
+		return 0, false
+	}
+	p.position += 2
+	return f, true
+}
+
 // tryParseTimeExpression handles standalone time like "HH:MM" or "HH:MM:SS"
 func (p *Parser) tryParseTimeExpression() (time.Time, bool, error) {
 	if p.position+2 >= len(p.tokens) {
 		return time.Time{}, false, nil
 	}
 
+	// PHP also separates the fields of 12-hour times with periods: "3.30pm".
+	startPos := p.position
+	dotted := p.tokens[p.position+1].Typ == TypeOperator && p.tokens[p.position+1].Val == "."
 	if p.tokens[p.position].Typ != TypeNumber ||
-		p.tokens[p.position+1].Typ != TypeOperator || p.tokens[p.position+1].Val != ":" ||
+		p.tokens[p.position+1].Typ != TypeOperator || (p.tokens[p.position+1].Val != ":" && !dotted) ||
 		p.tokens[p.position+2].Typ != TypeNumber {
 		return time.Time{}, false, nil
 	}
@@ -1761,39 +2387,31 @@ func (p *Parser) tryParseTimeExpression() (time.Time, bool, error) {
 	fraction := 0.0
 	hasFraction := false
 	if p.position+1 < len(p.tokens) &&
-		p.tokens[p.position].Typ == TypeOperator && p.tokens[p.position].Val == ":" &&
+		p.tokens[p.position].Typ == TypeOperator &&
+		(p.tokens[p.position].Val == ":" || p.tokens[p.position].Val == "." && p.meridianFollows(p.position+1)) &&
 		p.tokens[p.position+1].Typ == TypeNumber {
+		dotted = dotted || p.tokens[p.position].Val == "."
 		p.position++ // Skip :
 		s, err := strconv.Atoi(p.tokens[p.position].Val)
 		if err == nil && s >= 0 && s <= 59 {
 			second = s
 			p.position++
 		}
-		// Optional fractional seconds: . followed by digits
-		if p.position+1 < len(p.tokens) &&
-			p.tokens[p.position].Typ == TypeOperator && p.tokens[p.position].Val == "." &&
-			p.tokens[p.position+1].Typ == TypeNumber {
-			p.position++ // Skip .
-			fracStr := p.tokens[p.position].Val
-			if f, err := strconv.ParseFloat("0."+fracStr, 64); err == nil {
-				fraction = f
-				hasFraction = true
-				p.position++
-			}
-		}
+		fraction, hasFraction = p.tryParseFraction()
 	}
 
-	// Optional trailing am/pm (with or without separating whitespace).
-	// Also accepts PHP's dotted forms "a.m." / "p.m." as a 3-token sequence.
-	savedPos := p.position
-	p.skipWhitespace()
-	if p.position < len(p.tokens) && p.tokens[p.position].Typ == TypeString {
-		tok := strings.ToLower(p.tokens[p.position].Val)
-		switch tok {
-		case "am", "pm":
-			hour = applyAMPM(hour, tok)
-			p.position++
-		case "z":
+	// Optional trailing am/pm (with or without separating whitespace), for
+	// hours 1 to 12 only, as PHP's 12-hour formats.
+	if ampm, next, ok := p.meridianAt(p.position); ok && hour >= 1 && hour <= 12 {
+		hour = applyAMPM(hour, ampm)
+		p.position = next
+	} else if dotted {
+		p.position = startPos
+		return time.Time{}, false, nil
+	} else {
+		savedPos := p.position
+		p.skipWhitespace()
+		if p.position < len(p.tokens) && p.tokens[p.position].Typ == TypeString && strings.EqualFold(p.tokens[p.position].Val, "z") {
 			// Trailing Z marks UTC (PHP treats it as abbreviation).
 			if p.pd != nil {
 				p.pd.SetTZAbbreviation(time.UTC, "Z", 0, false)
@@ -1801,22 +2419,9 @@ func (p *Parser) tryParseTimeExpression() (time.Time, bool, error) {
 			p.loc = time.UTC
 			p.tzFound = true
 			p.position++
-		case "a", "p":
-			// "a.m." / "p.m." — require ".m." follow-up.
-			if p.position+3 < len(p.tokens) &&
-				p.tokens[p.position+1].Typ == TypeOperator && p.tokens[p.position+1].Val == "." &&
-				p.tokens[p.position+2].Typ == TypeString && strings.ToLower(p.tokens[p.position+2].Val) == "m" &&
-				p.tokens[p.position+3].Typ == TypeOperator && p.tokens[p.position+3].Val == "." {
-				hour = applyAMPM(hour, tok+"m")
-				p.position += 4
-			} else {
-				p.position = savedPos
-			}
-		default:
+		} else {
 			p.position = savedPos
 		}
-	} else {
-		p.position = savedPos
 	}
 
 	if p.pd != nil {
@@ -1848,6 +2453,37 @@ func (p *Parser) tryParseTimeExpression() (time.Time, bool, error) {
 	return time.Date(year, month, day, hour, minute, second, nanos, p.loc), true, nil
 }
 
+// meridianAt reads the "am" or "pm" of a 12-hour time at token i, after
+// optional whitespace, also written "a.m." or "p.m.". It returns the
+// meridian and the index of the token following it.
+func (p *Parser) meridianAt(i int) (string, int, bool) {
+	if i < len(p.tokens) && p.tokens[i].Typ == TypeWhitespace {
+		i++
+	}
+	if i >= len(p.tokens) || p.tokens[i].Typ != TypeString {
+		return "", 0, false
+	}
+	switch tok := strings.ToLower(p.tokens[i].Val); tok {
+	case "am", "pm":
+		return tok, i + 1, true
+	case "a", "p":
+		if i+3 < len(p.tokens) &&
+			p.tokens[i+1].Typ == TypeOperator && p.tokens[i+1].Val == "." &&
+			p.tokens[i+2].Typ == TypeString && strings.ToLower(p.tokens[i+2].Val) == "m" &&
+			p.tokens[i+3].Typ == TypeOperator && p.tokens[i+3].Val == "." {
+			return tok + "m", i + 4, true
+		}
+	}
+	return "", 0, false
+}
+
+// meridianFollows reports whether the number at token i is followed by a
+// meridian, as the seconds of "3.30.15pm" are.
+func (p *Parser) meridianFollows(i int) bool {
+	_, _, ok := p.meridianAt(i + 1)
+	return i < len(p.tokens) && p.tokens[i].Typ == TypeNumber && ok
+}
+
 // tryParseBareHourAMPM handles a bare hour followed by am/pm like "10am" or "10 pm"
 func (p *Parser) tryParseBareHourAMPM() (time.Time, bool, error) {
 	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeNumber {
@@ -1858,20 +2494,13 @@ func (p *Parser) tryParseBareHourAMPM() (time.Time, bool, error) {
 		return time.Time{}, false, nil
 	}
 
-	next := p.position + 1
-	if next < len(p.tokens) && p.tokens[next].Typ == TypeWhitespace {
-		next++
-	}
-	if next >= len(p.tokens) || p.tokens[next].Typ != TypeString {
-		return time.Time{}, false, nil
-	}
-	ampm := strings.ToLower(p.tokens[next].Val)
-	if ampm != "am" && ampm != "pm" {
+	ampm, next, ok := p.meridianAt(p.position + 1)
+	if !ok {
 		return time.Time{}, false, nil
 	}
 
 	hour = applyAMPM(hour, ampm)
-	p.position = next + 1
+	p.position = next
 
 	if p.pd != nil {
 		p.pd.SetTime(hour, 0, 0)
@@ -1883,7 +2512,7 @@ func (p *Parser) tryParseBareHourAMPM() (time.Time, bool, error) {
 // tryParseOrdinalRelativeTime handles ordinal words as implicit relative time
 // e.g., "eighth day" = +8 days
 func (p *Parser) tryParseOrdinalRelativeTime() (time.Time, bool, error) {
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+	if p.kindAt(p.position)&kindOrdinal == 0 {
 		return time.Time{}, false, nil
 	}
 
@@ -1895,12 +2524,15 @@ func (p *Parser) tryParseOrdinalRelativeTime() (time.Time, bool, error) {
 	p.position++
 	p.skipWhitespace()
 
-	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString {
+	if p.position >= len(p.tokens) || p.tokens[p.position].Typ != TypeString || !isOffsetUnit(p.tokens[p.position].Val) {
 		p.position = startPos
 		return time.Time{}, false, nil
 	}
 
 	result, err := p.applyTimeUnitOffset(amount, p.tokens[p.position].Val)
+	if errors.Is(err, ErrInvalidDate) {
+		return time.Time{}, true, err
+	}
 	if err != nil {
 		p.position = startPos
 		return time.Time{}, false, nil
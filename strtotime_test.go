@@ -152,6 +152,10 @@ func TestStrToTime(t *testing.T) {
 		"next month - 2 days + 12 hours",
 		"next year+1 month-2 days",
 
+		// Month arithmetic from the end of the month
+		"2023-05-30 -1 month",
+		"2023-05-31 -1 month",
+
 		// Mixed spacing in compound expressions
 		"next year+1 month + 2 days",
 		"next month + 1 week+3 days",
@@ -167,10 +171,6 @@ func TestStrToTime(t *testing.T) {
 		//"+2 hourss",
 		//"+2 hours",
 		//
-		// Commented out as these need complex month adjustment
-		//"2023-05-30 -1 month",
-		//"2023-05-31 -1 month",
-		//
 		// Skipped as this is a non-standard European format
 		//"24.11.22",
 	}
@@ -286,3 +286,110 @@ func TestUnixTimestampFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestUnixTimestampZone(t *testing.T) {
+	// A zone after the timestamp keeps the instant and changes how it is shown.
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"@1121373041 CEST", 2 * 3600},
+		{"@1121373041 +0200", 2 * 3600},
+		{"@1121373041 -05:00", -5 * 3600},
+		{"@1121373041.5 +0530", 5*3600 + 30*60},
+	}
+
+	for _, test := range tests {
+		result, err := StrToTime(test.input, InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("Error parsing '%s': %v", test.input, err)
+			continue
+		}
+		if result.Unix() != 1121373041 {
+			t.Errorf("For input '%s': expected seconds 1121373041, got %d", test.input, result.Unix())
+		}
+		if _, offset := result.Zone(); offset != test.offset {
+			t.Errorf("For input '%s': expected offset %d, got %d", test.input, test.offset, offset)
+		}
+	}
+}
+
+func TestFirstLastDayOfQuarter(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"first day of Q1 2024":      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"last day of Q2 2024":       time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC),
+		"first day of q3":           time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		"last day of q4 2023 18:00": time.Date(2023, 12, 31, 18, 0, 0, 0, time.UTC),
+		"last day of q1 2024":       time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+		} else if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	if got, err := StrToTime("last day of q5 2024", Rel(base)); err == nil {
+		t.Errorf("StrToTime(\"last day of q5 2024\") = %v, want an error", got)
+	}
+}
+
+func TestEmptyFraction(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"2008-08-07 18:11:31.0":   time.Date(2008, 8, 7, 18, 11, 31, 0, time.UTC),
+		"2008-08-07 18:11:31.":    time.Date(2008, 8, 7, 18, 11, 31, 0, time.UTC),
+		"10:30:45.":               time.Date(2024, 3, 1, 10, 30, 45, 0, time.UTC),
+		"10:30:45. +1 day":        time.Date(2024, 3, 2, 10, 30, 45, 0, time.UTC),
+		"Aug 7 2008 18:11:31.":    time.Date(2008, 8, 7, 18, 11, 31, 0, time.UTC),
+		"Aug 7 2008 18:11:31.250": time.Date(2008, 8, 7, 18, 11, 31, 250000000, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+
+	pd := DateParse("10:30:45.")
+	if pd.ErrorCount != 0 || !pd.Fraction.Set || pd.Fraction.V != 0 {
+		t.Errorf("DateParse(\"10:30:45.\") = %d errors, fraction %+v, want no errors and a zero fraction", pd.ErrorCount, pd.Fraction)
+	}
+}
+
+func TestSevenDigitFraction(t *testing.T) {
+	// .NET and Windows write fractions in ticks of 100ns.
+	tests := map[string]time.Time{
+		"2023-01-15T08:05:03.1234567-08:00": time.Date(2023, 1, 15, 8, 5, 3, 123456700, time.FixedZone("", -8*3600)),
+		"1/15/2023 8:05:03.1234567 AM":      time.Date(2023, 1, 15, 8, 5, 3, 123456700, time.UTC),
+		"1/15/2023 8:05:03.5 PM":            time.Date(2023, 1, 15, 20, 5, 3, 500000000, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
+
+func TestWhitespace(t *testing.T) {
+	want := time.Date(2023, 1, 15, 10, 30, 45, 0, time.UTC)
+	for _, input := range []string{
+		"2023-01-15\t10:30:45",
+		"2023-01-15   10:30:45",
+		"2023-01-15\n10:30:45",
+		" \t2023-01-15 \r\n 10:30:45 utc\n",
+		"Jan 15\t2023\t10:30:45",
+		"Jan\u00a015, 2023 10:30:45",
+		"Jan 15, 2023 10:30:45\u202fAM",
+		"15.01.2023\u200910:30:45",
+	} {
+		got, err := StrToTime(input, InTZ(time.UTC))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
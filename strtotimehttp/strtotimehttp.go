@@ -0,0 +1,100 @@
+// Package strtotimehttp reads times from HTTP requests: HTTP-date headers
+// such as If-Modified-Since, and query parameters such as ?since= and
+// ?until= holding anything strtotime.StrToTime accepts. Its errors carry
+// what a 400 response needs to explain the problem.
+package strtotimehttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/KarpelesLab/strtotime"
+)
+
+// Error reports a header or query parameter that could not be parsed.
+type Error struct {
+	In    string // "header" or "query"
+	Name  string // name of the header or parameter
+	Value string // value as received
+	Err   error  // underlying error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s %s %q: %v", e.In, e.Name, e.Value, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode returns http.StatusBadRequest, the status to answer a request
+// with an invalid time with.
+func (e *Error) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// ParseHTTPDate parses an HTTP-date in any of the three formats of RFC 9110:
+// the IMF-fixdate "Sun, 06 Nov 1994 08:49:37 GMT", the obsolete RFC 850
+// "Sunday, 06-Nov-94 08:49:37 GMT" and asctime's "Sun Nov  6 08:49:37 1994".
+// The time is returned in UTC.
+func ParseHTTPDate(value string) (time.Time, error) {
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: not an HTTP-date", strtotime.ErrInvalidDateFormat)
+	}
+	return t, nil
+}
+
+// HeaderTime parses the HTTP-date in the header name of h. It returns the
+// zero time if the header is absent.
+func HeaderTime(h http.Header, name string) (time.Time, error) {
+	value := h.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := ParseHTTPDate(value)
+	if err != nil {
+		return time.Time{}, &Error{In: "header", Name: http.CanonicalHeaderKey(name), Value: value, Err: err}
+	}
+	return t, nil
+}
+
+// IfModifiedSince returns the time in the If-Modified-Since header of r, or
+// the zero time if there is none. RFC 9110 has servers ignore an invalid
+// header rather than reject the request; the error lets them choose.
+func IfModifiedSince(r *http.Request) (time.Time, error) {
+	return HeaderTime(r.Header, "If-Modified-Since")
+}
+
+// QueryTime parses the query parameter name of q as StrToTime does, with
+// opts. It returns the zero time if the parameter is absent or empty.
+func QueryTime(q url.Values, name string, opts ...strtotime.Option) (time.Time, error) {
+	value := q.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := strtotime.StrToTime(value, opts...)
+	if err != nil {
+		return time.Time{}, &Error{In: "query", Name: name, Value: value, Err: err}
+	}
+	return t, nil
+}
+
+// QueryRange parses the "since" and "until" query parameters of q, as
+// QueryTime does. Either may be absent, leaving the zero time, but when
+// both are given until must not come before since.
+func QueryRange(q url.Values, opts ...strtotime.Option) (since, until time.Time, err error) {
+	if since, err = QueryTime(q, "since", opts...); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if until, err = QueryTime(q, "until", opts...); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		err = fmt.Errorf("%w: before since (%s)", strtotime.ErrInvalidInterval, since.Format(time.RFC3339))
+		return time.Time{}, time.Time{}, &Error{In: "query", Name: "until", Value: q.Get("until"), Err: err}
+	}
+	return since, until, nil
+}
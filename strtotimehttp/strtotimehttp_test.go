@@ -0,0 +1,79 @@
+package strtotimehttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/KarpelesLab/strtotime"
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	want := time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)
+	for _, value := range []string{
+		"Sun, 06 Nov 1994 08:49:37 GMT",
+		"Sunday, 06-Nov-94 08:49:37 GMT",
+		"Sun Nov  6 08:49:37 1994",
+	} {
+		got, err := ParseHTTPDate(value)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseHTTPDate(%q) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if _, err := ParseHTTPDate("yesterday"); !errors.Is(err, strtotime.ErrInvalidDateFormat) {
+		t.Errorf("ParseHTTPDate(\"yesterday\") error = %v", err)
+	}
+}
+
+func TestIfModifiedSince(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if got, err := IfModifiedSince(r); err != nil || !got.IsZero() {
+		t.Errorf("IfModifiedSince without header = %v, %v", got, err)
+	}
+
+	r.Header.Set("If-Modified-Since", "Sun, 06 Nov 1994 08:49:37 GMT")
+	if got, err := IfModifiedSince(r); err != nil || !got.Equal(time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)) {
+		t.Errorf("IfModifiedSince = %v, %v", got, err)
+	}
+
+	r.Header.Set("If-Modified-Since", "last week")
+	_, err := IfModifiedSince(r)
+	var herr *Error
+	if !errors.As(err, &herr) || herr.In != "header" || herr.Name != "If-Modified-Since" || herr.StatusCode() != http.StatusBadRequest {
+		t.Errorf("IfModifiedSince error = %#v", err)
+	}
+}
+
+func TestQueryRange(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	opts := []strtotime.Option{strtotime.Rel(base), strtotime.InTZ(time.UTC)}
+
+	since, until, err := QueryRange(url.Values{"since": {"-7 days"}, "until": {"now"}}, opts...)
+	if err != nil || !since.Equal(base.AddDate(0, 0, -7)) || !until.Equal(base) {
+		t.Errorf("QueryRange = %v, %v, %v", since, until, err)
+	}
+
+	since, until, err = QueryRange(url.Values{"since": {"2024-01-01"}}, opts...)
+	if err != nil || !since.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !until.IsZero() {
+		t.Errorf("QueryRange with since only = %v, %v, %v", since, until, err)
+	}
+
+	for _, q := range []url.Values{
+		{"since": {"the dawn of time"}},
+		{"until": {"13/45/2024"}},
+		{"since": {"today"}, "until": {"yesterday"}},
+	} {
+		_, _, err := QueryRange(q, opts...)
+		var herr *Error
+		if !errors.As(err, &herr) || herr.In != "query" || herr.StatusCode() != http.StatusBadRequest {
+			t.Errorf("QueryRange(%v) error = %v, want an *Error", q, err)
+		}
+	}
+	_, _, err = QueryRange(url.Values{"since": {"today"}, "until": {"yesterday"}}, opts...)
+	if !errors.Is(err, strtotime.ErrInvalidInterval) {
+		t.Errorf("QueryRange with until before since: error = %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/KarpelesLab/strtotime/strtotimepb
+
+go 1.25.3
+
+require (
+	github.com/KarpelesLab/strtotime v0.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.36.9
+)
+
+require github.com/KarpelesLab/gotz v0.1.2 // indirect
+
+replace github.com/KarpelesLab/strtotime => ../
@@ -0,0 +1,75 @@
+// Package strtotimepb converts between the strings strtotime understands and
+// the well-known protobuf types google.protobuf.Timestamp and
+// google.protobuf.Duration, so that gRPC services can accept human-friendly
+// strings in request fields.
+package strtotimepb
+
+import (
+	"errors"
+	"time"
+
+	"github.com/KarpelesLab/strtotime"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ParseTimestamp parses str as strtotime.StrToTime does, with opts, and
+// returns it as a Timestamp.
+func ParseTimestamp(str string, opts ...strtotime.Option) (*timestamppb.Timestamp, error) {
+	t, err := strtotime.StrToTime(str, opts...)
+	if err != nil {
+		return nil, err
+	}
+	ts := timestamppb.New(t)
+	if err := ts.CheckValid(); err != nil {
+		return nil, errors.Join(strtotime.ErrOutOfRange, err)
+	}
+	return ts, nil
+}
+
+// FormatTimestamp formats ts like PHP's date() (see strtotime.FormatPHP),
+// in loc or in UTC when loc is nil.
+func FormatTimestamp(ts *timestamppb.Timestamp, format string, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return strtotime.FormatPHP(format, ts.AsTime().In(loc))
+}
+
+// ParseDuration parses str as an ISO 8601 duration ("PT1H30M", see
+// strtotime.ParseISODuration) or a relative string ("1 day 2 hours", see
+// strtotime.IntervalFromDateString) and returns its length as a Duration.
+// Days, months and years have no fixed length: they are counted from the
+// base time set with strtotime.Rel, or the current time, so that "1 month"
+// from January 31st is the time to March 2nd in the MonthArithmetic
+// policy of opts.
+func ParseDuration(str string, opts ...strtotime.Option) (*durationpb.Duration, error) {
+	iv, err := strtotime.ParseISODuration(str)
+	if err != nil {
+		var rerr error
+		if iv, rerr = strtotime.IntervalFromDateString(str); rerr != nil {
+			return nil, rerr
+		}
+	}
+	base, err := strtotime.StrToTime("now", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return durationpb.New(iv.AddTo(base, opts...).Sub(base)), nil
+}
+
+// Interval breaks d down into hours, minutes, seconds and nanoseconds, with
+// Invert set for negative durations, for formatting with
+// strtotime.Interval's methods.
+func Interval(d *durationpb.Duration) strtotime.Interval {
+	dur := d.AsDuration()
+	var iv strtotime.Interval
+	if dur < 0 {
+		dur, iv.Invert = -dur, true
+	}
+	iv.Hours = int(dur / time.Hour)
+	iv.Minutes = int(dur % time.Hour / time.Minute)
+	iv.Seconds = int(dur % time.Minute / time.Second)
+	iv.Nanoseconds = int(dur % time.Second)
+	return iv
+}
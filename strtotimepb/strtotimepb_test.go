@@ -0,0 +1,74 @@
+package strtotimepb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KarpelesLab/strtotime"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	base := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	ts, err := ParseTimestamp("tomorrow noon", strtotime.Rel(base))
+	if err != nil {
+		t.Fatalf("ParseTimestamp: %v", err)
+	}
+	if want := time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC); !ts.AsTime().Equal(want) {
+		t.Errorf("ParseTimestamp = %v, want %v", ts.AsTime(), want)
+	}
+
+	if _, err := ParseTimestamp("not a date"); err == nil {
+		t.Error("ParseTimestamp(\"not a date\") succeeded")
+	}
+	if _, err := ParseTimestamp("10000-01-01", strtotime.ExtendedYears()); err == nil {
+		t.Error("ParseTimestamp accepted a year out of the Timestamp range")
+	}
+
+	got := FormatTimestamp(timestamppb.New(base), "Y-m-d H:i T", nil)
+	if got != "2024-01-31 10:00 UTC" {
+		t.Errorf("FormatTimestamp = %q", got)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	base := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		opts []strtotime.Option
+		want time.Duration
+	}{
+		{"PT1H30M", nil, 90 * time.Minute},
+		{"P1D", nil, 24 * time.Hour},
+		{"1 day 2 hours", nil, 26 * time.Hour},
+		{"3 hours ago", nil, -3 * time.Hour},
+		{"1 month", nil, 31 * 24 * time.Hour},
+		{"1 month", []strtotime.Option{strtotime.MonthArithmetic(strtotime.MonthClamp)}, 29 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		d, err := ParseDuration(tt.in, append(tt.opts, strtotime.Rel(base))...)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) error: %v", tt.in, err)
+			continue
+		}
+		if d.AsDuration() != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.in, d.AsDuration(), tt.want)
+		}
+	}
+
+	if _, err := ParseDuration("soon"); err == nil {
+		t.Error("ParseDuration(\"soon\") succeeded")
+	}
+}
+
+func TestInterval(t *testing.T) {
+	iv := Interval(durationpb.New(-(26*time.Hour + 3*time.Minute + 500*time.Millisecond)))
+	want := strtotime.Interval{Hours: 26, Minutes: 3, Nanoseconds: 500000000, Invert: true}
+	if iv != want {
+		t.Errorf("Interval = %+v, want %+v", iv, want)
+	}
+	if got := iv.Format("%r%H:%I:%S"); got != "-26:03:00" {
+		t.Errorf("Format = %q", got)
+	}
+}
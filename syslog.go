@@ -0,0 +1,135 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// syslogYearsBack bounds how far back ParseSyslog looks for a year in which
+// an RFC 3164 date exists. February 29th is at most 8 years back.
+const syslogYearsBack = 8
+
+// ParseSyslog parses the timestamp at the start of a syslog message and
+// returns it along with the rest of the message, stripped of its leading
+// whitespace. The message may start with its "<PRI>" part, and for RFC 5424
+// the version that follows it.
+//
+// RFC 5424 timestamps are the ISO 8601 profile of that RFC, such as
+// "2003-10-11T22:14:15.003Z" or "2003-08-24T05:14:15.000003-07:00". A
+// message whose timestamp is the NILVALUE "-" has none, which is an error.
+//
+// RFC 3164 timestamps, such as "Jan  5 03:04:05", have neither a year nor a
+// zone. They are read in the location set with InTZ, or the local one, and
+// in the latest year that puts them no more than a month after the base time
+// (see Rel), so that messages from December read in January are dated from
+// the year before. A fraction of a second after the time is accepted.
+func ParseSyslog(msg string, opts ...Option) (time.Time, string, error) {
+	s := trimLeftSpace(msg)
+	rfc5424 := false
+	if strings.HasPrefix(s, "<") {
+		end := strings.IndexByte(s, '>')
+		if end < 2 || end > 4 || !isAllDigits(s[1:end]) {
+			return time.Time{}, msg, fmt.Errorf("%w %q: malformed priority", ErrInvalidSyslog, msg)
+		}
+		s = s[end+1:]
+		// RFC 5424 puts its version, a number, and a space before the
+		// timestamp.
+		i := 0
+		for i < len(s) && i < 3 && isDigit(s[i]) {
+			i++
+		}
+		if i > 0 && i < len(s) && s[i] == ' ' && s[0] != '0' {
+			s, rfc5424 = s[i+1:], true
+		}
+	}
+
+	field, rest, _ := strings.Cut(s, " ")
+	if field == "-" {
+		return time.Time{}, msg, fmt.Errorf("%w %q: no timestamp", ErrInvalidSyslog, msg)
+	}
+	if t, ok := parseSyslog5424(field); ok {
+		return t, trimLeftSpace(rest), nil
+	}
+	if !rfc5424 {
+		now, loc := resolveOptions(withDefaults(opts))
+		if t, n, ok := parseSyslog3164(s, now, loc); ok {
+			return t, trimLeftSpace(s[n:]), nil
+		}
+	}
+	return time.Time{}, msg, fmt.Errorf("%w %q", ErrInvalidSyslog, msg)
+}
+
+// parseSyslog5424 parses an RFC 5424 timestamp: an ISO 8601 date and time
+// with a "T" between them, an optional fraction of a second and a "Z" or a
+// "+hh:mm" offset.
+func parseSyslog5424(field string) (time.Time, bool) {
+	n := len(field)
+	hasZone := n > 0 && (field[n-1] == 'Z' || field[n-1] == 'z')
+	if n > 6 && (field[n-6] == '+' || field[n-6] == '-') && field[n-3] == ':' {
+		hasZone = true
+	}
+	if n < 20 || field[4] != '-' || field[7] != '-' || (field[10] != 'T' && field[10] != 't') || !hasZone {
+		return time.Time{}, false
+	}
+	return parseISO8601DateTime(strings.ToLower(field), time.UTC)
+}
+
+// parseSyslog3164 parses an RFC 3164 timestamp at the start of s, "Mmm dd
+// hh:mm:ss" with the day padded with a space or a zero, and returns it with
+// the number of bytes read. The year is that of the latest such date no more
+// than a month after now.
+func parseSyslog3164(s string, now time.Time, loc *time.Location) (time.Time, int, bool) {
+	if len(s) < 4 || s[3] != ' ' {
+		return time.Time{}, 0, false
+	}
+	month, ok := getMonthByName(s[:3])
+	if !ok {
+		return time.Time{}, 0, false
+	}
+	i := 4
+	if i < len(s) && s[i] == ' ' {
+		i++
+	}
+	var day, hour, minute, second int
+	if day, i, ok = readDigitField(s, i, 1, 2); !ok || i >= len(s) || s[i] != ' ' {
+		return time.Time{}, 0, false
+	}
+	if hour, i, ok = readDigitField(s, i+1, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return time.Time{}, 0, false
+	}
+	if minute, i, ok = readDigitField(s, i+1, 2, 2); !ok || i >= len(s) || s[i] != ':' {
+		return time.Time{}, 0, false
+	}
+	if second, i, ok = readDigitField(s, i+1, 2, 2); !ok || !IsValidTime(hour, minute, second) {
+		return time.Time{}, 0, false
+	}
+	nanos := 0
+	if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+		j := i + 1
+		for j < len(s) && isDigit(s[j]) {
+			j++
+		}
+		frac := s[i+1 : min(j, i+10)]
+		nanos = digitsValue(frac + strings.Repeat("0", 9-len(frac)))
+		i = j
+	}
+	if i < len(s) && s[i] != ' ' {
+		return time.Time{}, 0, false
+	}
+
+	now = now.In(loc)
+	limit := now.AddDate(0, 1, 0)
+	for y := limit.Year(); y >= limit.Year()-syslogYearsBack; y-- {
+		if !IsValidDate(y, int(month), day) {
+			continue
+		}
+		t := time.Date(y, month, day, hour, minute, second, nanos, loc)
+		if !t.After(limit) {
+			return t, i, true
+		}
+	}
+	return time.Time{}, 0, false
+}
@@ -0,0 +1,55 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSyslog(t *testing.T) {
+	base := time.Date(2025, 1, 2, 8, 0, 0, 0, time.UTC)
+	minus7 := time.FixedZone("", -7*3600)
+	tests := []struct {
+		in   string
+		want time.Time
+		rest string
+	}{
+		{"Jan  5 03:04:05 host app: hello", time.Date(2025, 1, 5, 3, 4, 5, 0, time.UTC), "host app: hello"},
+		{"Dec 31 23:59:59 host app: late", time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC), "host app: late"},
+		{"Feb 1 00:00:00", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), ""},
+		{"Mar 15 12:00:00 host", time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC), "host"},
+		{"Feb 29 12:00:00 host", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC), "host"},
+		{"<34>Oct 11 22:14:15 mymachine su: 'su root' failed", time.Date(2024, 10, 11, 22, 14, 15, 0, time.UTC), "mymachine su: 'su root' failed"},
+		{"Jan 02 07:59:59.250 host", time.Date(2025, 1, 2, 7, 59, 59, 250000000, time.UTC), "host"},
+		{"<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47", time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC), "mymachine.example.com evntslog - ID47"},
+		{"<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc", time.Date(2003, 8, 24, 5, 14, 15, 3000, minus7), "192.0.2.1 myproc"},
+		{"2003-10-11T22:14:15Z host", time.Date(2003, 10, 11, 22, 14, 15, 0, time.UTC), "host"},
+	}
+	for _, tt := range tests {
+		got, rest, err := ParseSyslog(tt.in, Rel(base), InTZ(time.UTC))
+		if err != nil {
+			t.Errorf("ParseSyslog(%q) error: %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) || rest != tt.rest {
+			t.Errorf("ParseSyslog(%q) = %v, %q, want %v, %q", tt.in, got, rest, tt.want, tt.rest)
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"<165>1 - mymachine evntslog",
+		"<165>1 Oct 11 22:14:15 mymachine",
+		"<abc>Oct 11 22:14:15 host",
+		"Oct 11 25:14:15 host",
+		"Oct 32 22:14:15 host",
+		"2003-10-11T22:14:15 host",
+		"yesterday host",
+	} {
+		if _, _, err := ParseSyslog(in, Rel(base), InTZ(time.UTC)); !errors.Is(err, ErrInvalidSyslog) {
+			t.Errorf("ParseSyslog(%q) error = %v, want ErrInvalidSyslog", in, err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// TemplateFuncs returns functions for use in text/template and html/template
+// templates, to be given to their Funcs method:
+//
+//   - strtotime parses a string as StrToTime does, with opts.
+//   - date and formatPHP format a time like PHP's date() (see FormatPHP),
+//     taking the format first so that they end a pipeline.
+//   - humanize writes how far a time is from now in its largest unit, as in
+//     "3 days ago" or "in 2 hours"; "now" is the base time set with Rel, if
+//     any.
+//
+// With them, {{ "next friday" | strtotime | date "Y-m-d" }} writes the date
+// of next Friday. Parse errors stop the execution of the template.
+func TemplateFuncs(opts ...Option) map[string]any {
+	return map[string]any{
+		"strtotime": func(str string) (time.Time, error) {
+			return StrToTime(str, opts...)
+		},
+		"date":      FormatPHP,
+		"formatPHP": FormatPHP,
+		"humanize": func(t time.Time) string {
+			now, _ := resolveOptions(withDefaults(opts))
+			return humanizeFrom(now, t)
+		},
+	}
+}
+
+// humanizeFrom writes how far t is from now in its largest unit.
+func humanizeFrom(now, t time.Time) string {
+	if d := t.Sub(now); d > -time.Second && d < time.Second {
+		return "now"
+	}
+	iv := Diff(now, t)
+	if iv.Invert {
+		return iv.Humanize(1) + " ago"
+	}
+	return "in " + iv.Humanize(1)
+}
@@ -0,0 +1,42 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	funcs := TemplateFuncs(Rel(base), InTZ(time.UTC))
+	tests := []struct {
+		text, want string
+	}{
+		{`{{ "next friday" | strtotime | date "Y-m-d" }}`, "2024-03-08"},
+		{`{{ "2024-06-01 13:05" | strtotime | formatPHP "D, j M Y H:i" }}`, "Sat, 1 Jun 2024 13:05"},
+		{`{{ "+3 days 4 hours" | strtotime | humanize }}`, "in 3 days"},
+		{`{{ "-2 hours" | strtotime | humanize }}`, "2 hours ago"},
+		{`{{ "now" | strtotime | humanize }}`, "now"},
+	}
+	for _, tt := range tests {
+		tmpl := template.Must(template.New("").Funcs(funcs).Parse(tt.text))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, nil); err != nil {
+			t.Errorf("%s: %v", tt.text, err)
+			continue
+		}
+		if b.String() != tt.want {
+			t.Errorf("%s = %q, want %q", tt.text, b.String(), tt.want)
+		}
+	}
+
+	// The map suits html/template too, and parse errors fail the execution.
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(funcs).Parse(`{{ "not a date" | strtotime }}`))
+	if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+		t.Error("executing a template with an invalid date succeeded")
+	}
+}
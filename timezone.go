@@ -2,8 +2,8 @@ package strtotime
 
 import (
 	"strings"
+	"sync"
 	"time"
-	"unicode"
 
 	"github.com/KarpelesLab/gotz"
 )
@@ -129,6 +129,22 @@ func loadLocation(name string) (*time.Location, error) {
 	return z.Location()
 }
 
+// zoneNames holds the lowercase names of the zones of the embedded database.
+var zoneNames = sync.OnceValue(func() map[string]bool {
+	names := gotz.Names()
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[strings.ToLower(name)] = true
+	}
+	return m
+})
+
+// isZoneName reports whether name, in any case, is the name of a zone of
+// the embedded database.
+func isZoneName(name string) bool {
+	return zoneNames()[strings.ToLower(name)]
+}
+
 // tryParseTimezone attempts to parse a timezone from a string
 // It handles both abbreviations (PST, EST) and full names (America/New_York, Europe/Paris)
 func tryParseTimezone(tzString string) (*time.Location, bool) {
@@ -137,6 +153,11 @@ func tryParseTimezone(tzString string) (*time.Location, bool) {
 		return nil, false
 	}
 
+	// Offsets from GMT or UTC, "GMT+3" or "UTC+05:30"
+	if loc, ok := parseGMTOffsetZone(tzString); ok {
+		return loc, true
+	}
+
 	// If the timezone contains invalid characters, reject it immediately
 	for _, c := range tzString {
 		// Valid timezone characters: alphanumeric, /, _, -, + and spaces
@@ -161,55 +182,57 @@ func tryParseTimezone(tzString string) (*time.Location, bool) {
 		}
 	}
 
-	// Strategy 3: Try direct load with original case
+	// The embedded database is searched regardless of case, which also
+	// covers "america/new_york" or "EUROPE/PARIS". Words that name no zone
+	// stop here, without the cost of a failed lookup.
+	if !isZoneName(tzString) {
+		return nil, false
+	}
 	if loc, err := loadLocation(tzString); err == nil {
 		return loc, true
 	}
 
-	// Strategy 4: Handle region/city format with proper casing
-	parts := strings.Split(tzString, "/")
-	if len(parts) == 2 {
-		// Check that both parts are non-empty
-		if len(parts[0]) == 0 || len(parts[1]) == 0 {
-			return nil, false
-		}
-
-		// Convert to proper case: first letter uppercase, rest lowercase
-		region := titleCase(strings.ToLower(parts[0]))
-		city := titleCase(strings.ToLower(parts[1]))
-		tzPropCase := region + "/" + city
+	return nil, false
+}
 
-		if loc, err := loadLocation(tzPropCase); err == nil {
-			return loc, true
-		}
+// parseGMTOffsetZone parses a zone written as an offset from GMT or UTC,
+// with the hours alone or followed by the minutes, with or without a colon:
+// "GMT+3", "UTC-0700", "GMT+05:30". Unlike the IANA "Etc/GMT+3", the sign
+// is the one of the offset.
+func parseGMTOffsetZone(s string) (*time.Location, bool) {
+	if len(s) < 5 || !(hasPrefixFold(s, "gmt") || hasPrefixFold(s, "utc")) {
+		return nil, false
+	}
+	rest := s[3:]
+	sign := 1
+	switch rest[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		return nil, false
 	}
+	rest = rest[1:]
 
-	// Strategy 5: Handle underscores in timezone names (like "America/New_York")
-	if strings.Contains(tzString, "_") {
-		// Replace underscores with spaces for proper processing
-		tzNoUnderscores := strings.ReplaceAll(tzString, "_", " ")
-		parts := strings.Split(tzNoUnderscores, "/")
-
-		if len(parts) == 2 {
-			// Check that both parts are non-empty
-			if len(parts[0]) == 0 || len(parts[1]) == 0 {
-				return nil, false
-			}
-
-			// Title case each part and replace spaces with underscore
-			region := titleCase(strings.ToLower(parts[0]))
-			city := titleCase(strings.ToLower(parts[1]))
-			// Replace spaces back with underscores for IANA format
-			city = strings.ReplaceAll(city, " ", "_")
-			tzPropCase := region + "/" + city
-
-			if loc, err := loadLocation(tzPropCase); err == nil {
-				return loc, true
-			}
+	var hours, minutes string
+	if h, m, ok := strings.Cut(rest, ":"); ok {
+		hours, minutes = h, m
+		if len(minutes) != 2 {
+			return nil, false
 		}
+	} else if len(rest) == 4 {
+		hours, minutes = rest[:2], rest[2:]
+	} else {
+		hours = rest
 	}
-
-	return nil, false
+	if len(hours) > 2 || !isAllDigits(hours) || (minutes != "" && !isAllDigits(minutes)) {
+		return nil, false
+	}
+	h, m := digitsValue(hours), digitsValue(minutes)
+	if h > 14 || m > 59 {
+		return nil, false
+	}
+	return fixedZone(sign * (h*3600 + m*60)), true
 }
 
 // isValidTimezoneChar checks if a character is valid in a timezone string
@@ -219,16 +242,3 @@ func isValidTimezoneChar(c rune) bool {
 		(c >= '0' && c <= '9') ||
 		c == '/' || c == '_' || c == '-' || c == '+' || c == ' '
 }
-
-// titleCase converts the first letter of each word to uppercase
-func titleCase(s string) string {
-	prev := ' '
-	return strings.Map(func(r rune) rune {
-		if unicode.IsSpace(prev) || prev == '_' || prev == '/' {
-			prev = r
-			return unicode.ToUpper(r)
-		}
-		prev = r
-		return r
-	}, s)
-}
@@ -0,0 +1,47 @@
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGMTOffsetZone(t *testing.T) {
+	tests := map[string]int{
+		"gmt+3":     3 * 3600,
+		"GMT-5":     -5 * 3600,
+		"utc+05:30": 5*3600 + 30*60,
+		"UTC-0700":  -7 * 3600,
+		"gmt+1:45":  3600 + 45*60,
+		"gmt-12":    -12 * 3600,
+	}
+	for input, want := range tests {
+		loc, ok := parseGMTOffsetZone(input)
+		if !ok {
+			t.Errorf("parseGMTOffsetZone(%q) failed", input)
+			continue
+		}
+		if _, offset := time.Now().In(loc).Zone(); offset != want {
+			t.Errorf("parseGMTOffsetZone(%q) offset = %d, want %d", input, offset, want)
+		}
+	}
+	for _, input := range []string{"gmt", "gmt+", "gmt+15", "gmt+123", "gmt+05:3", "gmt 3", "est+3", "utc+05:60"} {
+		if _, ok := parseGMTOffsetZone(input); ok {
+			t.Errorf("parseGMTOffsetZone(%q) succeeded, want failure", input)
+		}
+	}
+
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for input, want := range map[string]time.Time{
+		"2024-03-05 10:00 UTC-0700":    time.Date(2024, 3, 5, 17, 0, 0, 0, time.UTC),
+		"tomorrow 10am UTC+05:30":      time.Date(2024, 3, 2, 4, 30, 0, 0, time.UTC),
+		"10:00 GMT+3":                  time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC),
+		"2024-03-05T10:00:00 GMT+3":    time.Date(2024, 3, 5, 7, 0, 0, 0, time.UTC),
+		"Sun, 06 Nov 1994 08:49 GMT":   time.Date(1994, 11, 6, 8, 49, 0, 0, time.UTC),
+		"Jan 15 2023 10:00am GMT+0200": time.Date(2023, 1, 15, 8, 0, 0, 0, time.UTC),
+	} {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil || !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
@@ -23,9 +23,17 @@ func Tokenize(s string) []Token {
 	if len(s) == 0 {
 		return nil
 	}
-
 	// Pre-allocate with estimated capacity (most inputs have 3-10 tokens)
-	tokens := make([]Token, 0, 8)
+	return tokenizeInto(make([]Token, 0, 8), s)
+}
+
+// tokenizeInto appends the tokens of s to dst and returns the extended slice.
+func tokenizeInto(dst []Token, s string) []Token {
+	if len(s) == 0 {
+		return dst
+	}
+
+	tokens := dst
 	currentType := classifyByte(s[0])
 	start := 0
 
@@ -69,6 +77,71 @@ func classifyByte(c byte) tokenType {
 	}
 }
 
+// tokenKind flags the keyword classes a string token belongs to. A token may
+// belong to several ("mon" is both a weekday and a unit, "m" both a unit and
+// a military time zone).
+type tokenKind uint16
+
+const (
+	kindMonth       tokenKind = 1 << iota // january, feb, ...
+	kindWeekday                           // monday, tue, ...
+	kindUnit                              // day, weeks, hrs, ...
+	kindDirection                         // next, last, this
+	kindOrdinal                           // first ... twelfth
+	kindDayKeyword                        // now, today, tomorrow, yesterday
+	kindTimeKeyword                       // midnight, noon
+	kindTZ                                // zone abbreviation (est, utc, z, ...)
+
+	// kindKeyword covers the classes that are never the start of a zone name
+	// unless kindTZ is also set.
+	kindKeyword = kindMonth | kindWeekday | kindUnit | kindDirection |
+		kindOrdinal | kindDayKeyword | kindTimeKeyword
+)
+
+// classifyTokens appends to dst the keyword kinds of each token of toks
+// (0 for non-string tokens and unknown words) and returns the extended
+// slice. Matching is on the lowercase form StrToTime works with, so that
+// rules can test a kind instead of comparing strings.
+func classifyTokens(dst []tokenKind, toks []Token) []tokenKind {
+	for _, t := range toks {
+		var k tokenKind
+		if t.Typ == TypeString {
+			k = classifyWord(t.Val)
+		}
+		dst = append(dst, k)
+	}
+	return dst
+}
+
+// classifyWord returns the keyword kinds of a single word.
+func classifyWord(w string) tokenKind {
+	var k tokenKind
+	switch w {
+	case DirectionNext, DirectionLast, "this":
+		k |= kindDirection
+	case "now", "today", "tomorrow", "yesterday":
+		k |= kindDayKeyword
+	case "midnight", "noon":
+		k |= kindTimeKeyword
+	}
+	if _, ok := getMonthByName(w); ok {
+		k |= kindMonth
+	}
+	if getDayOfWeek(w) >= 0 {
+		k |= kindWeekday
+	}
+	if _, ok := unitMap[w]; ok {
+		k |= kindUnit
+	}
+	if ordinalWordToNumber(w) > 0 {
+		k |= kindOrdinal
+	}
+	if _, ok := timezoneAbbreviations[w]; ok {
+		k |= kindTZ
+	}
+	return k
+}
+
 // isOperator checks if a rune is an operator
 func isOperator(r rune) bool {
 	return r == '+' || r == '-' || r == ':' || r == '/' || r == '.'
@@ -78,3 +151,84 @@ func isOperator(r rune) bool {
 func isPunctuation(r rune) bool {
 	return r == ',' || r == ';' || r == '(' || r == ')' || r == '[' || r == ']'
 }
+
+// tokenCursor walks a token stream one whitespace-separated field at a time.
+// Its readers only match when the token(s) they consume make up a whole
+// field, so "1monday" is not read as a number followed by a word.
+type tokenCursor struct {
+	src  string // the string toks were cut from
+	toks []Token
+	i    int
+}
+
+// newTokenCursor tokenizes s into buf and returns a cursor over the tokens.
+func newTokenCursor(s string, buf []Token) tokenCursor {
+	return tokenCursor{src: s, toks: tokenizeInto(buf[:0], s)}
+}
+
+// skipSpace advances past whitespace tokens.
+func (c *tokenCursor) skipSpace() {
+	for c.i < len(c.toks) && c.toks[c.i].Typ == TypeWhitespace {
+		c.i++
+	}
+}
+
+// done reports whether only whitespace remains.
+func (c *tokenCursor) done() bool {
+	c.skipSpace()
+	return c.i >= len(c.toks)
+}
+
+// fieldEndsAt reports whether a field ends right before token j.
+func (c *tokenCursor) fieldEndsAt(j int) bool {
+	return j >= len(c.toks) || c.toks[j].Typ == TypeWhitespace
+}
+
+// word reads a field made of a single string token, optionally followed by a
+// period ("sept.", "wks."), and returns it with the period included.
+func (c *tokenCursor) word() (string, bool) {
+	if c.done() || c.toks[c.i].Typ != TypeString {
+		return "", false
+	}
+	start, j := c.toks[c.i].Pos, c.i+1
+	end := start + len(c.toks[c.i].Val)
+	if j < len(c.toks) && c.toks[j].Val == "." {
+		end++
+		j++
+	}
+	if !c.fieldEndsAt(j) {
+		return "", false
+	}
+	c.i = j
+	return c.src[start:end], true
+}
+
+// peekWord returns the next field if it is a word, without consuming it.
+func (c *tokenCursor) peekWord() (string, bool) {
+	save := c.i
+	w, ok := c.word()
+	c.i = save
+	return w, ok
+}
+
+// number reads a field made of a single number token.
+func (c *tokenCursor) number() (string, bool) {
+	if c.done() || c.toks[c.i].Typ != TypeNumber || !c.fieldEndsAt(c.i+1) {
+		return "", false
+	}
+	c.i++
+	return c.toks[c.i-1].Val, true
+}
+
+// rest returns the input from the next field to the end.
+func (c *tokenCursor) rest() string {
+	if c.done() {
+		return ""
+	}
+	return c.src[c.toks[c.i].Pos:]
+}
+
+// skipAll consumes every remaining token.
+func (c *tokenCursor) skipAll() {
+	c.i = len(c.toks)
+}
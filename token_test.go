@@ -74,3 +74,45 @@ func TestTokenize(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenCursor(t *testing.T) {
+	var buf [8]Token
+	c := newTokenCursor("sept. 1monday 2008 10:30", buf[:])
+
+	if w, ok := c.word(); !ok || w != "sept." {
+		t.Fatalf("word() = %q, %v, want \"sept.\", true", w, ok)
+	}
+	if _, ok := c.number(); ok {
+		t.Fatal("number() matched part of \"1monday\"")
+	}
+	if _, ok := c.word(); ok {
+		t.Fatal("word() matched a field starting with a digit")
+	}
+	c.i += 2 // skip "1monday"
+	if n, ok := c.number(); !ok || n != "2008" {
+		t.Fatalf("number() = %q, %v, want \"2008\", true", n, ok)
+	}
+	if r := c.rest(); r != "10:30" {
+		t.Fatalf("rest() = %q, want \"10:30\"", r)
+	}
+	c.skipAll()
+	if !c.done() {
+		t.Fatal("done() = false after skipAll")
+	}
+}
+
+func TestClassifyTokens(t *testing.T) {
+	toks := Tokenize("next mon 3 weeks noon z")
+	kinds := classifyTokens(nil, toks)
+	want := []tokenKind{
+		kindDirection, 0,
+		kindWeekday | kindUnit, 0,
+		0, 0,
+		kindUnit, 0,
+		kindTimeKeyword, 0,
+		kindTZ,
+	}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("classifyTokens = %v, want %v", kinds, want)
+	}
+}
@@ -0,0 +1,80 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import "time"
+
+// parseWeekReference parses week numbers written out in words: "week 5
+// 2024", "week 5 of 2024", "w05 2024" and "week 23", and returns the Monday
+// of that ISO 8601 week at midnight. Without a year the week is taken in the
+// current one. Weeks past the last of the year (53 in a 52-week year) are
+// rejected.
+func parseWeekReference(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	var buf [8]Token
+	c := newTokenCursor(str, buf[:])
+
+	week, ok := readWeekNumber(&c)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	year := now.Year()
+	if w, ok := c.peekWord(); ok {
+		if w != "of" && w != "in" {
+			return time.Time{}, false
+		}
+		c.word()
+	}
+	if !c.done() {
+		y, ok := c.number()
+		if !ok || len(y) != 4 {
+			return time.Time{}, false
+		}
+		year = digitsValue(y)
+	}
+	if !c.done() || year < 1 || week < 1 || week > isoWeeksInYear(year) {
+		return time.Time{}, false
+	}
+
+	return time.Date(year, time.January, 1+isoWeekDayOffset(year, week, 1), 0, 0, 0, 0, loc), true
+}
+
+// readWeekNumber reads "week N" or "wNN", the number having one or two
+// digits after "week" and exactly two after "w".
+func readWeekNumber(c *tokenCursor) (int, bool) {
+	if w, ok := c.peekWord(); ok {
+		if w != "week" {
+			return 0, false
+		}
+		c.word()
+		n, ok := c.number()
+		if !ok || len(n) > 2 {
+			return 0, false
+		}
+		return digitsValue(n), true
+	}
+	if c.done() || c.toks[c.i].Val != "w" || c.fieldEndsAt(c.i+1) ||
+		c.toks[c.i+1].Typ != TypeNumber || len(c.toks[c.i+1].Val) != 2 || !c.fieldEndsAt(c.i+2) {
+		return 0, false
+	}
+	c.i += 2
+	return digitsValue(c.toks[c.i-1].Val), true
+}
+
+// isoWeeksInYear returns the number of ISO 8601 weeks in year, 52 or 53.
+// December 28th always falls in the last one.
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+func parseWeekReferenceInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseWeekReference(str, now, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(0, 0, 0)
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,43 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekReference(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"week 5 2024":    time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		"week 5 of 2024": time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		"week 5 in 2024": time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		"W05 2024":       time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		"week 23":        time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC),
+		"Week 1 2021":    time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		"week 53 2020":   time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"week 0 2024",
+		"week 53 2021",
+		"week 5 of march",
+		"w5 2024",
+		"week 123",
+	} {
+		if got, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) = %v, want an error", input, got)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"strings"
+	"time"
+)
+
+// coincidenceSearchMonths bounds the search for a weekday/day-of-month
+// coincidence when no year is given. Any such pair recurs within 400 years
+// since the Gregorian calendar repeats with that period.
+const coincidenceSearchMonths = 400 * 12
+
+// parseWeekdayCoincidence parses expressions naming a weekday that falls on
+// a given day of the month, such as "friday the 13th", "next friday the 13th",
+// "last monday the 1st" or "second monday the 1st of 2025", and returns the
+// matching date at midnight.
+//
+// Without a year, a bare or "this" expression finds the first match on or
+// after the base date, "next" the first one after it and "last" the most
+// recent one before it. An ordinal picks the Nth match on or after the base
+// date. With "of <year>" (or "in <year>") the search covers that year only: an
+// ordinal counts from January and "last" from December.
+func parseWeekdayCoincidence(str string, now time.Time, loc *time.Location) (time.Time, bool) {
+	var buf [16]Token
+	c := newTokenCursor(str, buf[:])
+
+	count, backward, strict := 1, false, false
+	if w, ok := c.peekWord(); ok && getDayOfWeek(w) < 0 {
+		switch {
+		case w == DirectionNext:
+			strict = true
+		case w == DirectionLast:
+			backward, strict = true, true
+		case w == "this":
+		case ordinalWordToNumber(w) > 0:
+			count = ordinalWordToNumber(w)
+		default:
+			return time.Time{}, false
+		}
+		c.word()
+	} else if n, ok := readSuffixedNumber(&c); ok {
+		if n < 1 {
+			return time.Time{}, false
+		}
+		count = n
+	}
+
+	w, ok := c.word()
+	if !ok {
+		return time.Time{}, false
+	}
+	weekday := getDayOfWeek(w)
+	if weekday < 0 {
+		return time.Time{}, false
+	}
+	if w, ok := c.word(); !ok || w != "the" {
+		return time.Time{}, false
+	}
+	day, ok := readSuffixedNumber(&c)
+	if !ok || day < 1 || day > 31 {
+		return time.Time{}, false
+	}
+
+	year, hasYear := 0, false
+	if w, ok := c.word(); ok {
+		if w != "of" && w != "in" {
+			return time.Time{}, false
+		}
+		y, ok := c.number()
+		if !ok || len(y) != 4 {
+			return time.Time{}, false
+		}
+		year, hasYear = digitsValue(y), true
+	}
+	if !c.done() {
+		return time.Time{}, false
+	}
+
+	// Walk month by month from the starting point, counting the months whose
+	// day falls on the weekday.
+	var y int
+	var m time.Month
+	var from time.Time // matches must not be before (or after, backward) this
+	months := coincidenceSearchMonths
+	if hasYear {
+		y, m, months = year, time.January, 12
+		if backward {
+			m = time.December
+		}
+		strict = false
+	} else {
+		y, m, _ = now.Date()
+		from = time.Date(y, m, now.Day(), 0, 0, 0, 0, loc)
+	}
+
+	for range months {
+		if day <= daysInMonth(y, m) {
+			t := time.Date(y, m, day, 0, 0, 0, 0, loc)
+			if int(t.Weekday()) == weekday && coincidenceInRange(t, from, backward, strict) {
+				if count--; count == 0 {
+					return t, true
+				}
+			}
+		}
+		if backward {
+			if m--; m < time.January {
+				m, y = time.December, y-1
+			}
+		} else if m++; m > time.December {
+			m, y = time.January, y+1
+		}
+	}
+	return time.Time{}, false
+}
+
+// coincidenceInRange reports whether t lies on the searched side of from.
+// A zero from accepts every date.
+func coincidenceInRange(t, from time.Time, backward, strict bool) bool {
+	switch {
+	case from.IsZero():
+		return true
+	case backward:
+		return t.Before(from) || (!strict && t.Equal(from))
+	default:
+		return t.After(from) || (!strict && t.Equal(from))
+	}
+}
+
+// readSuffixedNumber reads a number field with an optional English ordinal
+// suffix matching it ("13", "13th", "1st").
+func readSuffixedNumber(c *tokenCursor) (int, bool) {
+	if c.done() || c.toks[c.i].Typ != TypeNumber || len(c.toks[c.i].Val) > maxDigitField {
+		return 0, false
+	}
+	n := digitsValue(c.toks[c.i].Val)
+	switch {
+	case c.fieldEndsAt(c.i + 1):
+		c.i++
+	case c.toks[c.i+1].Typ == TypeString && c.fieldEndsAt(c.i+2) &&
+		strings.EqualFold(c.toks[c.i+1].Val, ordinalSuffix(n)):
+		c.i += 2
+	default:
+		return 0, false
+	}
+	return n, true
+}
+
+func parseWeekdayCoincidenceInto(str string, now time.Time, loc *time.Location, opts []Option, pd *ParsedDate) bool {
+	t, ok := parseWeekdayCoincidence(str, now, loc)
+	if !ok {
+		return false
+	}
+	pd.SetDate(t.Year(), int(t.Month()), t.Day())
+	pd.SetTime(0, 0, 0)
+	pd.setMaterialized(t)
+	return true
+}
@@ -0,0 +1,46 @@
+//go:build !strtotime_minimal
+
+package strtotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekdayCoincidence(t *testing.T) {
+	base := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	tests := map[string]time.Time{
+		"friday the 13th":              time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC),
+		"next friday the 13th":         time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC),
+		"last friday the 13th":         time.Date(2023, 10, 13, 0, 0, 0, 0, time.UTC),
+		"second friday the 13th":       time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC),
+		"friday the 1st":               time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		"next friday the 1st":          time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+		"first monday the 1st of 2025": time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		"friday the 13th in 2026":      time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC),
+		"last friday the 13th of 2026": time.Date(2026, 11, 13, 0, 0, 0, 0, time.UTC),
+		"Tuesday the 31st":             time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		"friday the 13":                time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := StrToTime(input, Rel(base))
+		if err != nil {
+			t.Errorf("StrToTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("StrToTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{
+		"friday the 13rd",
+		"friday the 32nd",
+		"friday the 13th of march",
+		"fifth monday the 31st of 2024",
+	} {
+		if _, err := StrToTime(input, Rel(base)); err == nil {
+			t.Errorf("StrToTime(%q) succeeded, want error", input)
+		}
+	}
+}